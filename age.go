@@ -0,0 +1,121 @@
+package personnummer
+
+import (
+	"sync"
+	"time"
+)
+
+// defaultLocationName is the location used when calculating age if no location
+// is set.
+const defaultLocationName = "Europe/Stockholm"
+
+// nolint: gochecknoglobal
+var (
+	defaultLocation     *time.Location
+	defaultLocationOnce sync.Once
+)
+
+// AgeDetail holds the age of a person by calendar.
+type AgeDetail struct {
+	Years  int
+	Months int
+	Days   int
+
+	// DaysUntilNextBirthday is the number of days until the next birthday, 0
+	// on the birthday.
+	DaysUntilNextBirthday int
+}
+
+// DefaultLocation returns the location used when calculating age if no location
+// is set, Europe/Stockholm. If the time zone database isn't available UTC is
+// used instead, so a birthday may be reached up to two hours late. Programs
+// that run on systems without a time zone database, such as scratch containers
+// or Windows, should import time/tzdata in their main package or set a
+// location in the options.
+func DefaultLocation() *time.Location {
+	defaultLocationOnce.Do(func() {
+		loc, err := time.LoadLocation(defaultLocationName)
+		if err != nil {
+			loc = time.UTC
+		}
+
+		defaultLocation = loc
+	})
+
+	return defaultLocation
+}
+
+// CalculateAge returns the age by calendar of someone born at the date of birth
+// at time t. The date of t is taken in loc, or DefaultLocation if loc is nil,
+// so a birthday is reached at midnight local time. Only the date of birth is
+// used, not the time or location.
+//
+// The age is counted in whole years, then whole months and then days. A
+// birthday or monthly anniversary on a day that doesn't exist in a month, such
+// as the 29th of February in a year that isn't a leap year, is reached on the
+// first day of the following month. A person born on the 29th of February is
+// thus a year older on the 1st of March in years that aren't leap years.
+//
+// If t is before the date of birth the zero value is returned.
+func CalculateAge(birth, t time.Time, loc *time.Location) AgeDetail {
+	if loc == nil {
+		loc = DefaultLocation()
+	}
+
+	t = t.In(loc)
+
+	var (
+		today      = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		year, m, d = birth.Date()
+		month      = int(m)
+	)
+
+	if anniversary(year, month, d).After(today) {
+		return AgeDetail{}
+	}
+
+	years := today.Year() - year
+	if anniversary(year+years, month, d).After(today) {
+		years--
+	}
+
+	months := 0
+	for months < 11 && !anniversary(year+years, month+months+1, d).After(today) {
+		months++
+	}
+
+	next := anniversary(year+years+1, month, d)
+	last := anniversary(year+years, month+months, d)
+
+	detail := AgeDetail{
+		Years:                 years,
+		Months:                months,
+		Days:                  daysBetween(last, today),
+		DaysUntilNextBirthday: daysBetween(today, next),
+	}
+
+	if detail.Months == 0 && detail.Days == 0 {
+		detail.DaysUntilNextBirthday = 0
+	}
+
+	return detail
+}
+
+// anniversary returns the date with the day in the year and month, the month
+// may be above 12. If the day doesn't exist in the month the first day of the
+// following month is returned.
+func anniversary(year, month, day int) time.Time {
+	// Normalize the year and month before checking the number of days.
+	first := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+
+	if day > first.AddDate(0, 1, -1).Day() {
+		return first.AddDate(0, 1, 0)
+	}
+
+	return first.AddDate(0, 0, day-1)
+}
+
+// daysBetween returns the number of days between two dates at midnight UTC.
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
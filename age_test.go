@@ -0,0 +1,123 @@
+package personnummer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateAge(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	cases := []struct {
+		description string
+		birth       time.Time
+		at          time.Time
+		loc         *time.Location
+		age         AgeDetail
+	}{
+		{
+			description: "on birthday",
+			birth:       date(1980, 1, 1),
+			at:          date(2020, 1, 1),
+			age:         AgeDetail{Years: 40},
+		},
+		{
+			description: "day before birthday",
+			birth:       date(1980, 1, 1),
+			at:          date(2019, 12, 31),
+			age:         AgeDetail{Years: 39, Months: 11, Days: 30, DaysUntilNextBirthday: 1},
+		},
+		{
+			description: "off by one with 365 day years",
+			birth:       date(1920, 6, 15),
+			at:          date(2020, 6, 14),
+			age:         AgeDetail{Years: 99, Months: 11, Days: 30, DaysUntilNextBirthday: 1},
+		},
+		{
+			description: "months and days",
+			birth:       date(2000, 3, 15),
+			at:          date(2020, 8, 20),
+			age:         AgeDetail{Years: 20, Months: 5, Days: 5, DaysUntilNextBirthday: 207},
+		},
+		{
+			description: "leap day birthday in leap year",
+			birth:       date(2004, 2, 29),
+			at:          date(2020, 2, 29),
+			age:         AgeDetail{Years: 16},
+		},
+		{
+			description: "leap day birthday not reached on the 28th",
+			birth:       date(2004, 2, 29),
+			at:          date(2022, 2, 28),
+			age:         AgeDetail{Years: 17, Months: 11, Days: 30, DaysUntilNextBirthday: 1},
+		},
+		{
+			description: "leap day birthday reached on the 1st of March",
+			birth:       date(2004, 2, 29),
+			at:          date(2022, 3, 1),
+			age:         AgeDetail{Years: 18},
+		},
+		{
+			description: "monthly anniversary on missing day",
+			birth:       date(2000, 1, 31),
+			at:          date(2021, 3, 1),
+			age:         AgeDetail{Years: 21, Months: 1, DaysUntilNextBirthday: 336},
+		},
+		{
+			description: "birthday reached at midnight in Stockholm",
+			birth:       date(2000, 3, 15),
+			at:          time.Date(2020, 3, 14, 23, 30, 0, 0, time.UTC),
+			age:         AgeDetail{Years: 20},
+		},
+		{
+			description: "birthday not reached at midnight in UTC",
+			birth:       date(2000, 3, 15),
+			at:          time.Date(2020, 3, 14, 23, 30, 0, 0, time.UTC),
+			loc:         time.UTC,
+			age:         AgeDetail{Years: 19, Months: 11, Days: 28, DaysUntilNextBirthday: 1},
+		},
+		{
+			description: "before birth",
+			birth:       date(2000, 3, 15),
+			at:          date(1999, 3, 15),
+			age:         AgeDetail{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.age, CalculateAge(tc.birth, tc.at, tc.loc))
+		})
+	}
+}
+
+func TestPerson_AgeDetailAt(t *testing.T) {
+	at := time.Date(2022, 2, 28, 23, 30, 0, 0, time.UTC)
+
+	person, err := NewPerson("20040229-1234")
+	require.NoError(t, err)
+
+	// It's already the 1st of March in Stockholm.
+	assert.Equal(t, 18, person.AgeAt(at))
+	assert.Equal(t, AgeDetail{Years: 18}, person.AgeDetailAt(at))
+	assert.True(t, person.IsOfAgeAt(at, 18))
+
+	person, err = NewPersonWithOptions("20040229-1234", Options{
+		Now:      func() time.Time { return at },
+		Location: time.UTC,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 17, person.Age())
+	assert.Equal(t, AgeDetail{Years: 17, Months: 11, Days: 30, DaysUntilNextBirthday: 1}, person.AgeDetail())
+	assert.False(t, person.IsOfAge(18))
+}
+
+func TestDefaultLocation(t *testing.T) {
+	assert.Contains(t, []string{defaultLocationName, "UTC"}, DefaultLocation().String())
+}
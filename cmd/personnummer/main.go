@@ -0,0 +1,489 @@
+// Command personnummer validates, inspects, formats and generates Swedish
+// identification numbers.
+//
+// Usage:
+//
+//	personnummer validate [--json] [--lenient] [number ...]
+//	personnummer info [--json] [--lenient] [number ...]
+//	personnummer format [--style long] [--lenient] [number ...]
+//	personnummer generate [--gender female] [--born 1999-02-20] [--count 50]
+//	                      [--seed 1] [--json]
+//
+// Flags can be given before or after the numbers. If no numbers are given they
+// are read from stdin, one per line. Unless --seed is given, generate prints
+// the random seed it used on stderr.
+//
+// The exit code is 0 on success, 1 if any number is invalid and 2 on usage
+// errors.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	// Age is calculated in Europe/Stockholm, embed the time zone database so
+	// it works on systems without one.
+	_ "time/tzdata"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+const (
+	exitOK      = 0
+	exitInvalid = 1
+	exitUsage   = 2
+)
+
+const usage = `Usage: personnummer <command> [flags] [number ...]
+
+Commands:
+  validate  Validate numbers
+  info      Print information about numbers
+  format    Format numbers
+  generate  Generate personal identity numbers
+
+Flags can be given before or after the numbers. If no numbers are given they
+are read from stdin, one per line.
+Run 'personnummer <command> --help' for flags.
+`
+
+// nolint: gochecknoglobal
+var styles = map[string]personnummer.Format{
+	"short":             personnummer.FormatShort,
+	"short-no-divider":  personnummer.FormatShortNoDivider,
+	"long":              personnummer.FormatLong,
+	"long-with-divider": personnummer.FormatLongWithDivider,
+	"org16":             personnummer.FormatOrgWithPrefix16,
+}
+
+// info is the information printed for a number.
+type info struct {
+	Input         string `json:"input"`
+	Valid         bool   `json:"valid"`
+	Error         string `json:"error,omitempty"`
+	Kind          string `json:"kind,omitempty"`
+	Number        string `json:"number,omitempty"`
+	Date          string `json:"date,omitempty"`
+	Age           *int   `json:"age,omitempty"`
+	Gender        string `json:"gender,omitempty"`
+	County        string `json:"county,omitempty"`
+	Coordination  *bool  `json:"coordination,omitempty"`
+	CorporateForm string `json:"corporate_form,omitempty"`
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) < 1 {
+		fmt.Fprint(stderr, usage)
+
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "validate":
+		return runValidate(args[1:], stdin, stdout, stderr)
+	case "info":
+		return runInfo(args[1:], stdin, stdout, stderr)
+	case "format":
+		return runFormat(args[1:], stdin, stdout, stderr)
+	case "generate":
+		return runGenerate(args[1:], stdout, stderr)
+	case "help", "-h", "--help":
+		fmt.Fprint(stdout, usage)
+
+		return exitOK
+	}
+
+	fmt.Fprintf(stderr, "unknown command %q\n\n%s", args[0], usage)
+
+	return exitUsage
+}
+
+func runValidate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	asJSON := fs.Bool("json", false, "print result as JSON")
+	lenient := fs.Bool("lenient", false, "normalize input before parsing")
+
+	inputs, err := parseFlags(fs, args)
+	if err != nil {
+		return exitUsage
+	}
+
+	return eachInput(inputs, stdin, stderr, func(input string) bool {
+		i := identify(input, *lenient)
+
+		switch {
+		case *asJSON:
+			printJSON(stdout, struct {
+				Input string `json:"input"`
+				Valid bool   `json:"valid"`
+				Kind  string `json:"kind,omitempty"`
+				Error string `json:"error,omitempty"`
+			}{i.Input, i.Valid, i.Kind, i.Error})
+		case i.Valid:
+			fmt.Fprintf(stdout, "%s\tvalid\t%s\n", input, i.Kind)
+		default:
+			fmt.Fprintf(stdout, "%s\tinvalid\t%s\n", input, i.Error)
+		}
+
+		return i.Valid
+	})
+}
+
+func runInfo(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("info", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	asJSON := fs.Bool("json", false, "print result as JSON")
+	lenient := fs.Bool("lenient", false, "normalize input before parsing")
+
+	inputs, err := parseFlags(fs, args)
+	if err != nil {
+		return exitUsage
+	}
+
+	return eachInput(inputs, stdin, stderr, func(input string) bool {
+		i := identify(input, *lenient)
+
+		if *asJSON {
+			printJSON(stdout, i)
+
+			return i.Valid
+		}
+
+		if !i.Valid {
+			fmt.Fprintf(stdout, "%s\tinvalid\t%s\n", input, i.Error)
+
+			return false
+		}
+
+		fmt.Fprintf(stdout, "Input:          %s\n", i.Input)
+		fmt.Fprintf(stdout, "Kind:           %s\n", i.Kind)
+		fmt.Fprintf(stdout, "Number:         %s\n", i.Number)
+
+		if i.Date != "" {
+			fmt.Fprintf(stdout, "Date:           %s\n", i.Date)
+			fmt.Fprintf(stdout, "Age:            %d\n", *i.Age)
+			fmt.Fprintf(stdout, "Gender:         %s\n", i.Gender)
+			fmt.Fprintf(stdout, "County:         %s\n", i.County)
+			fmt.Fprintf(stdout, "Coordination:   %t\n", *i.Coordination)
+		}
+
+		if i.CorporateForm != "" {
+			fmt.Fprintf(stdout, "Corporate form: %s\n", i.CorporateForm)
+		}
+
+		fmt.Fprintln(stdout)
+
+		return true
+	})
+}
+
+func runFormat(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("format", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	style := fs.String("style", "short", "output style: short, short-no-divider, long, long-with-divider or org16")
+	lenient := fs.Bool("lenient", false, "normalize input before parsing")
+
+	inputs, err := parseFlags(fs, args)
+	if err != nil {
+		return exitUsage
+	}
+
+	format, ok := styles[*style]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown style %q\n", *style)
+
+		return exitUsage
+	}
+
+	return eachInput(inputs, stdin, stderr, func(input string) bool {
+		identity, err := personnummer.IdentifyWithOptions(input, options(*lenient))
+		if err != nil {
+			fmt.Fprintf(stderr, "%s\tinvalid\t%s\n", input, err)
+
+			return false
+		}
+
+		switch {
+		case identity.Organization != nil:
+			fmt.Fprintln(stdout, identity.Organization.Format(format))
+		case identity.Interim != nil:
+			fmt.Fprintln(stdout, identity.Interim.Format(format))
+		default:
+			fmt.Fprintln(stdout, identity.Person.Format(format))
+		}
+
+		return true
+	})
+}
+
+func runGenerate(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("generate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	gender := fs.String("gender", "", "gender of the generated people, male or female (default random)")
+	born := fs.String("born", "", "birth date of the generated people as YYYY-MM-DD (default random)")
+	count := fs.Int("count", 1, "number of people to generate")
+	style := fs.String("style", "long-with-divider", "output style, see format")
+	seed := fs.Int64("seed", 0, "seed to reproduce the same numbers (default random)")
+	asJSON := fs.Bool("json", false, "print result as JSON")
+
+	inputs, err := parseFlags(fs, args)
+	if err != nil {
+		return exitUsage
+	}
+
+	if len(inputs) > 0 {
+		fmt.Fprintf(stderr, "generate takes no arguments, got %q\n", inputs)
+
+		return exitUsage
+	}
+
+	if !isFlagSet(fs, "seed") {
+		// Print the random seed so the same numbers can be generated again.
+		*seed = time.Now().UnixNano()
+		fmt.Fprintf(stderr, "seed: %d\n", *seed)
+	}
+
+	generator := personnummer.NewGeneratorWithSeed(*seed)
+
+	format, ok := styles[*style]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown style %q\n", *style)
+
+		return exitUsage
+	}
+
+	var date time.Time
+
+	if *born != "" {
+		d, err := time.Parse("2006-01-02", *born)
+		if err != nil {
+			fmt.Fprintf(stderr, "invalid birth date %q: %s\n", *born, err)
+
+			return exitUsage
+		}
+
+		date = d
+	}
+
+	genders := map[string]personnummer.Gender{
+		"male":   personnummer.Male,
+		"female": personnummer.Female,
+	}
+
+	g, ok := genders[strings.ToLower(*gender)]
+	if *gender != "" && !ok {
+		fmt.Fprintf(stderr, "unknown gender %q\n", *gender)
+
+		return exitUsage
+	}
+
+	for i := 0; i < *count; i++ {
+		person, err := generate(generator, date, *gender != "", g)
+		if err != nil {
+			fmt.Fprintf(stderr, "could not generate: %s\n", err)
+
+			return exitInvalid
+		}
+
+		if *asJSON {
+			printJSON(stdout, infoFromPerson(person.Format(format), person))
+
+			continue
+		}
+
+		fmt.Fprintln(stdout, person.Format(format))
+	}
+
+	return exitOK
+}
+
+// generate generates a person with the given birth date and gender, if set.
+func generate(
+	generator *personnummer.Generator,
+	date time.Time,
+	hasGender bool,
+	gender personnummer.Gender,
+) (*personnummer.Person, error) {
+	if !date.IsZero() && hasGender {
+		return generator.Person(date, gender)
+	}
+
+	random, err := generator.AnyPerson()
+	if err != nil {
+		return nil, err
+	}
+
+	if date.IsZero() {
+		date = random.Date
+	}
+
+	if !hasGender {
+		gender = random.Gender
+	}
+
+	return generator.Person(date, gender)
+}
+
+// parseFlags parses the flags in args and returns the remaining arguments.
+// Unlike fs.Parse it doesn't stop at the first argument that isn't a flag so
+// flags can be given both before and after the numbers. Everything after "--"
+// is returned as arguments.
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var inputs []string
+
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return inputs, nil
+		}
+
+		if parsed := len(args) - len(rest); parsed > 0 && args[parsed-1] == "--" {
+			return append(inputs, rest...), nil
+		}
+
+		inputs = append(inputs, rest[0])
+		args = rest[1:]
+	}
+}
+
+// isFlagSet returns true if the flag with the given name was set.
+func isFlagSet(fs *flag.FlagSet, name string) bool {
+	set := false
+
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+
+	return set
+}
+
+// eachInput calls fn for each input in args or, if no args are given, each
+// non empty line read from stdin. The exit code is exitInvalid if fn returned
+// false for any input.
+func eachInput(args []string, stdin io.Reader, stderr io.Writer, fn func(string) bool) int {
+	code := exitOK
+
+	handle := func(input string) {
+		if !fn(input) {
+			code = exitInvalid
+		}
+	}
+
+	if len(args) > 0 {
+		for _, arg := range args {
+			handle(arg)
+		}
+
+		return code
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		handle(line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(stderr, "could not read input: %s\n", err)
+
+		return exitUsage
+	}
+
+	return code
+}
+
+// identify identifies the input and returns the information to print.
+func identify(input string, lenient bool) info {
+	identity, err := personnummer.IdentifyWithOptions(input, options(lenient))
+	if err != nil {
+		return info{Input: input, Error: err.Error()}
+	}
+
+	switch {
+	case identity.Organization != nil:
+		return info{
+			Input:         input,
+			Valid:         true,
+			Kind:          identity.Kind.String(),
+			Number:        identity.Organization.Format(personnummer.FormatShort),
+			CorporateForm: identity.Organization.CorporateForm.String(),
+		}
+	case identity.Interim != nil:
+		i := infoFromPerson(input, identity.Person)
+		i.Number = identity.Interim.Format(personnummer.FormatLongWithDivider)
+		i.Kind = identity.Kind.String()
+
+		return i
+	default:
+		i := infoFromPerson(input, identity.Person)
+		i.Kind = identity.Kind.String()
+
+		return i
+	}
+}
+
+// infoFromPerson returns the information to print for a person.
+func infoFromPerson(input string, person *personnummer.Person) info {
+	var (
+		age          = person.Age()
+		coordination = person.IsCoordination
+		kind         = personnummer.KindPerson
+	)
+
+	if coordination {
+		kind = personnummer.KindCoordination
+	}
+
+	return info{
+		Input:        input,
+		Valid:        true,
+		Kind:         kind.String(),
+		Number:       person.Format(personnummer.FormatLongWithDivider),
+		Date:         person.Date.Format("2006-01-02"),
+		Age:          &age,
+		Gender:       person.Gender.String(),
+		County:       person.County.String(),
+		Coordination: &coordination,
+	}
+}
+
+// options returns the options to use when identifying input.
+func options(lenient bool) personnummer.Options {
+	if lenient {
+		return personnummer.Options{Mode: personnummer.ModeLenient}
+	}
+
+	return personnummer.Options{}
+}
+
+// printJSON prints v as JSON on a single line.
+func printJSON(w io.Writer, v interface{}) {
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		panic(err)
+	}
+}
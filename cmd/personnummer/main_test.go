@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	cases := []struct {
+		description string
+		args        []string
+		stdin       string
+		code        int
+		stdout      string
+	}{
+		{
+			description: "no command",
+			args:        []string{},
+			code:        exitUsage,
+		},
+		{
+			description: "unknown command",
+			args:        []string{"foo"},
+			code:        exitUsage,
+		},
+		{
+			description: "validate valid",
+			args:        []string{"validate", "800101-3294"},
+			code:        exitOK,
+			stdout:      "800101-3294\tvalid\tPersonal identity number\n",
+		},
+		{
+			description: "validate invalid from stdin",
+			args:        []string{"validate"},
+			stdin:       "800101-3294\n\n800101-3295\n",
+			code:        exitInvalid,
+			stdout: "800101-3294\tvalid\tPersonal identity number\n" +
+				"800101-3295\tinvalid\tinvalid checksum at position 10: expected 4, got 5\n",
+		},
+		{
+			description: "validate json",
+			args:        []string{"validate", "--json", "556703-7485"},
+			code:        exitOK,
+			stdout:      `{"input":"556703-7485","valid":true,"kind":"Organization number"}` + "\n",
+		},
+		{
+			description: "validate json after number",
+			args:        []string{"validate", "800101-3294", "--json"},
+			code:        exitOK,
+			stdout:      `{"input":"800101-3294","valid":true,"kind":"Personal identity number"}` + "\n",
+		},
+		{
+			description: "validate after double dash",
+			args:        []string{"validate", "--", "--json"},
+			code:        exitInvalid,
+			stdout:      "--json\tinvalid\tinvalid format\n",
+		},
+		{
+			description: "validate unknown flag after number",
+			args:        []string{"validate", "800101-3294", "--foo"},
+			code:        exitUsage,
+		},
+		{
+			description: "info json",
+			args:        []string{"info", "--json", "556703-7485"},
+			code:        exitOK,
+			stdout: `{"input":"556703-7485","valid":true,"kind":"Organization number",` +
+				`"number":"556703-7485","corporate_form":"Aktiebolag"}` + "\n",
+		},
+		{
+			description: "format long",
+			args:        []string{"format", "--style", "long", "800101-3294", "556703-7485"},
+			code:        exitOK,
+			stdout:      "198001013294\n165567037485\n",
+		},
+		{
+			description: "format lenient",
+			args:        []string{"format", "--lenient", "800101 3294"},
+			code:        exitOK,
+			stdout:      "800101-3294\n",
+		},
+		{
+			description: "format unknown style",
+			args:        []string{"format", "--style", "foo", "800101-3294"},
+			code:        exitUsage,
+		},
+		{
+			description: "format lenient after numbers",
+			args:        []string{"format", "800101 3294", "556703 7485", "--style", "long", "--lenient"},
+			code:        exitOK,
+			stdout:      "198001013294\n165567037485\n",
+		},
+		{
+			description: "generate with arguments",
+			args:        []string{"generate", "800101-3294"},
+			code:        exitUsage,
+		},
+		{
+			description: "generate invalid gender",
+			args:        []string{"generate", "--gender", "foo"},
+			code:        exitUsage,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			code := run(tc.args, strings.NewReader(tc.stdin), &stdout, &stderr)
+
+			assert.Equal(t, tc.code, code)
+
+			if tc.stdout != "" {
+				assert.Equal(t, tc.stdout, stdout.String())
+			}
+		})
+	}
+}
+
+func TestRun_Generate(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	code := run(
+		[]string{"generate", "--gender", "female", "--born", "1999-02-20", "--count", "5"},
+		strings.NewReader(""), &stdout, &stderr,
+	)
+
+	assert.Equal(t, exitOK, code)
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	assert.Len(t, lines, 5)
+
+	for _, line := range lines {
+		assert.True(t, strings.HasPrefix(line, "19990220-"))
+
+		var out bytes.Buffer
+
+		assert.Equal(t, exitOK, run([]string{"validate", line}, nil, &out, &stderr))
+		assert.Contains(t, out.String(), "valid")
+	}
+}
+
+func TestRun_GenerateSeed(t *testing.T) {
+	generate := func() string {
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"generate", "--seed", "42", "--count", "10"}, nil, &stdout, &stderr)
+		assert.Equal(t, exitOK, code)
+
+		return stdout.String()
+	}
+
+	assert.Equal(t, generate(), generate())
+}
+
+func TestRun_GeneratePrintsSeed(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	code := run([]string{"generate", "--count", "10"}, nil, &stdout, &stderr)
+	assert.Equal(t, exitOK, code)
+
+	var seed string
+
+	_, err := fmt.Sscanf(stderr.String(), "seed: %s\n", &seed)
+	require.NoError(t, err)
+
+	var again bytes.Buffer
+
+	code = run([]string{"generate", "--count", "10", "--seed", seed}, nil, &again, &stderr)
+	assert.Equal(t, exitOK, code)
+	assert.Equal(t, stdout.String(), again.String())
+}
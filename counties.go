@@ -1,9 +1,5 @@
 package personnummer
 
-import (
-	"errors"
-)
-
 // County represents the counties within Sweden. This could be told from the
 // serial number before 1990. See
 // https://en.wikipedia.org/wiki/Personal_identity_number_(Sweden)#Format
@@ -165,5 +161,5 @@ func CountyFromSerial(serial int) (County, error) {
 		return CountyQQ, nil
 	}
 
-	return County(-1), errors.New("invalid serial")
+	return County(-1), ErrInvalidSerial
 }
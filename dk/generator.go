@@ -0,0 +1,84 @@
+package dk
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+	"github.com/bombsimon/go-personnummer/internal/national"
+)
+
+// nolint: gochecknoglobal
+var defaultGenerator = NewGenerator(nil)
+
+// Generator generates valid numbers from its own random source. Two
+// generators created with the same seed will generate the same numbers in the
+// same order. A Generator is safe for concurrent use and the zero value uses a
+// source seeded with the current time.
+type Generator struct {
+	rand national.Rand
+}
+
+// NewGenerator returns a new Generator using the passed source, or a source
+// seeded with the current time if nil.
+func NewGenerator(src rand.Source) *Generator {
+	return &Generator{
+		rand: national.Rand{Source: src},
+	}
+}
+
+// NewGeneratorWithSeed returns a new Generator using a source with the passed
+// seed.
+func NewGeneratorWithSeed(seed int64) *Generator {
+	return NewGenerator(rand.NewSource(seed))
+}
+
+// Generate will generate a valid Danish CPR number based on the passed date
+// and gender.
+func Generate(date time.Time, gender personnummer.Gender) (*Person, error) {
+	return defaultGenerator.Person(date, gender)
+}
+
+// Person will generate a valid Danish CPR number based on the passed date and
+// gender. Only dates between 1858 and 2057 can be generated. The generated
+// numbers pass the legacy mod 11 check so they are valid with and without
+// Options.Mod11.
+func (g *Generator) Person(date time.Time, gender personnummer.Gender) (*Person, error) {
+	if gender != personnummer.Male && gender != personnummer.Female {
+		return nil, personnummer.ErrInvalidGender
+	}
+
+	digits := serialDigits(date.Year())
+	if len(digits) == 0 {
+		return nil, personnummer.ErrInvalidDate
+	}
+
+	for {
+		serial := digits[g.rand.Intn(len(digits))]*1000 + g.rand.Intn(1000)
+		if genderFromSerial(serial) != gender {
+			continue
+		}
+
+		nr := fmt.Sprintf("%02d%02d%02d%04d", date.Day(), int(date.Month()), date.Year()%100, serial)
+		if mod11(nr) != 0 {
+			continue
+		}
+
+		return NewPerson(nr)
+	}
+}
+
+// serialDigits returns the first serial digits that give the century of the
+// year, see Century.
+func serialDigits(year int) []int {
+	var digits []int
+
+	for digit := 0; digit <= 9; digit++ {
+		if Century(digit, year%100)+year%100 == year {
+			digits = append(digits, digit)
+		}
+	}
+
+	return digits
+}
@@ -0,0 +1,43 @@
+package dk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+func TestGenerate(t *testing.T) {
+	dates := []time.Time{
+		time.Date(1858, 6, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1936, 12, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2004, 2, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2057, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, date := range dates {
+		for _, gender := range []personnummer.Gender{personnummer.Male, personnummer.Female} {
+			p, err := Generate(date, gender)
+			require.NoError(t, err)
+
+			assert.Equal(t, date, p.Date, p.Number)
+			assert.Equal(t, gender, p.Gender)
+			assert.True(t, p.Valid())
+			assert.True(t, p.ValidMod11())
+		}
+	}
+}
+
+func TestGenerate_Invalid(t *testing.T) {
+	_, err := Generate(time.Date(1857, 1, 1, 0, 0, 0, 0, time.UTC), personnummer.Male)
+	assert.Equal(t, personnummer.ErrInvalidDate, err)
+
+	_, err = Generate(time.Date(2058, 1, 1, 0, 0, 0, 0, time.UTC), personnummer.Male)
+	assert.Equal(t, personnummer.ErrInvalidDate, err)
+
+	_, err = Generate(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), personnummer.Gender(3))
+	assert.Equal(t, personnummer.ErrInvalidGender, err)
+}
@@ -0,0 +1,222 @@
+// Package dk parses, validates and generates Danish personal identification
+// numbers (CPR-nummer).
+//
+// A CPR number is ten digits, DDMMYY-SSSS, where DDMMYY is the date of birth
+// and SSSS is a serial number. The century is told by the first digit of the
+// serial number and the year and the last digit tells the gender, odd for
+// males and even for females.
+//
+// Numbers issued before 2007 satisfy a mod 11 check but since then numbers
+// without a valid check are issued, so the check is only done if asked for
+// with Options.Mod11.
+package dk
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+	"github.com/bombsimon/go-personnummer/internal/national"
+)
+
+// numberLength is the number of digits in a number.
+const numberLength = 10
+
+// mod11Weights are the weights used in the legacy mod 11 check.
+// nolint: gochecknoglobal
+var mod11Weights = [...]int{4, 3, 2, 7, 6, 5, 4, 3, 2, 1}
+
+// defaultLocation is the location used when calculating age if not set in the
+// options.
+// nolint: gochecknoglobal
+var defaultLocation = national.NewLocation("Europe/Copenhagen")
+
+// birth gives Person the age methods, see national.Birth.
+type birth = national.Birth
+
+// Options holds settings used when creating a Person. The zero value is ready
+// to use.
+type Options struct {
+	// Now returns the reference time used when calculating age. Defaults to
+	// time.Now.
+	Now func() time.Time
+
+	// Location is the location used to tell the date when calculating age.
+	// Defaults to Europe/Copenhagen.
+	Location *time.Location
+
+	// Mod11 makes Validate also do the legacy mod 11 check. Numbers issued
+	// since 2007 may not pass it.
+	Mod11 bool
+}
+
+// Person represents a parsed Danish CPR number. It has the same age methods as
+// personnummer.Person, such as Age and IsOfAge.
+type Person struct {
+	// Number is the ten digits of the number, DDMMYYSSSS.
+	Number string
+	Date   time.Time
+	Serial int
+	Gender personnummer.Gender
+
+	mod11 bool
+	birth
+}
+
+// NewPerson parses and returns a pointer to a Person based on the input. The
+// input is ten digits, optionally with a dash or space after the date. An
+// error is returned if the input isn't in the right format or the date isn't
+// valid.
+func NewPerson(input string) (*Person, error) {
+	return NewPersonWithOptions(input, Options{})
+}
+
+// NewPersonWithOptions works like NewPerson but uses the passed options.
+func NewPersonWithOptions(input string, options Options) (*Person, error) {
+	nr := strings.TrimSpace(input)
+	if len(nr) == numberLength+1 && (nr[6] == '-' || nr[6] == ' ') {
+		nr = nr[:6] + nr[7:]
+	}
+
+	if len(nr) != numberLength {
+		return nil, personnummer.ErrInvalidFormat
+	}
+
+	for i := 0; i < len(nr); i++ {
+		if nr[i] < '0' || nr[i] > '9' {
+			return nil, personnummer.ErrInvalidFormat
+		}
+	}
+
+	var (
+		day, _    = strconv.Atoi(nr[0:2])
+		month, _  = strconv.Atoi(nr[2:4])
+		year, _   = strconv.Atoi(nr[4:6])
+		serial, _ = strconv.Atoi(nr[6:])
+	)
+
+	year += Century(serial/1000, year)
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day || int(date.Month()) != month {
+		return nil, personnummer.ErrInvalidDate
+	}
+
+	return &Person{
+		Number: nr,
+		Date:   date,
+		Serial: serial,
+		Gender: genderFromSerial(serial),
+		mod11:  options.Mod11,
+		birth: national.NewBirth(date, national.Options{
+			Now:      options.Now,
+			Location: options.Location,
+		}, defaultLocation),
+	}, nil
+}
+
+// IsValidPerson returns if the input is a valid CPR number. The legacy mod 11
+// check isn't done.
+func IsValidPerson(input string) bool {
+	p, err := NewPerson(input)
+	if err != nil {
+		return false
+	}
+
+	return p.Valid()
+}
+
+// Century returns the century for the first serial digit and the two digit
+// year.
+//
+//   - 0-3 is used for 1900-1999.
+//   - 4 and 9 are used for 2000-2036 and 1937-1999.
+//   - 5-8 are used for 2000-2057 and 1858-1899.
+func Century(digit, year int) int {
+	switch {
+	case digit <= 3:
+		return 1900
+	case digit == 4 || digit == 9:
+		if year <= 36 {
+			return 2000
+		}
+
+		return 1900
+	default:
+		if year <= 57 {
+			return 2000
+		}
+
+		return 1800
+	}
+}
+
+// Validate returns an error if the number isn't valid. The date is validated
+// when parsing so only the legacy mod 11 check is done, and only if Mod11 is
+// set in the options the person was created with.
+func (p *Person) Validate() error {
+	if len(p.Number) != numberLength {
+		return personnummer.ErrInvalidFormat
+	}
+
+	if p.mod11 && !p.ValidMod11() {
+		return personnummer.ErrInvalidChecksum
+	}
+
+	return nil
+}
+
+// Valid returns if the number is valid, see Validate.
+func (p *Person) Valid() bool {
+	return p.Validate() == nil
+}
+
+// ValidMod11 returns if the number passes the legacy mod 11 check used for
+// numbers issued before 2007.
+func (p *Person) ValidMod11() bool {
+	if len(p.Number) != numberLength {
+		return false
+	}
+
+	return mod11(p.Number) == 0
+}
+
+// String returns the number in the form DDMMYY-SSSS.
+func (p *Person) String() string {
+	if len(p.Number) != numberLength {
+		return p.Number
+	}
+
+	return p.Number[:6] + "-" + p.Number[6:]
+}
+
+// Male returns true if the person is a male.
+func (p *Person) Male() bool {
+	return p.Gender == personnummer.Male
+}
+
+// Female returns true if the person is a female.
+func (p *Person) Female() bool {
+	return p.Gender == personnummer.Female
+}
+
+// genderFromSerial returns the gender from the last digit of the serial
+// number, odd for males and even for females.
+func genderFromSerial(serial int) personnummer.Gender {
+	if serial%2 == 1 {
+		return personnummer.Male
+	}
+
+	return personnummer.Female
+}
+
+// mod11 returns the weighted sum of the digits modulo 11.
+func mod11(digits string) int {
+	sum := 0
+	for i, w := range mod11Weights {
+		sum += int(digits[i]-'0') * w
+	}
+
+	return sum % 11
+}
@@ -0,0 +1,108 @@
+package dk
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+func TestNewPerson(t *testing.T) {
+	cases := []struct {
+		input  string
+		date   string
+		gender personnummer.Gender
+		string string
+		mod11  bool
+		err    error
+	}{
+		{input: "010180-0008", date: "1980-01-01", gender: personnummer.Female, string: "010180-0008", mod11: true},
+		{input: "0101800016", date: "1980-01-01", gender: personnummer.Female, string: "010180-0016", mod11: true},
+		{input: "010180 0059", date: "1980-01-01", gender: personnummer.Male, string: "010180-0059", mod11: true},
+		{input: "010180-0009", date: "1980-01-01", gender: personnummer.Male, string: "010180-0009"},
+		{input: "010180-4001", date: "1980-01-01", gender: personnummer.Male, string: "010180-4001"},
+		{input: "010110-4001", date: "2010-01-01", gender: personnummer.Male, string: "010110-4001", mod11: true},
+		{input: "010137-9002", date: "1937-01-01", gender: personnummer.Female, string: "010137-9002"},
+		{input: "010136-9002", date: "2036-01-01", gender: personnummer.Female, string: "010136-9002"},
+		{input: "010157-5000", date: "2057-01-01", gender: personnummer.Female, string: "010157-5000"},
+		{input: "010158-8000", date: "1858-01-01", gender: personnummer.Female, string: "010158-8000"},
+		{input: "290204-0008", date: "1904-02-29", gender: personnummer.Female, string: "290204-0008", mod11: true},
+		{input: "290204-4008", date: "2004-02-29", gender: personnummer.Female, string: "290204-4008"},
+		{input: "290203-4008", err: personnummer.ErrInvalidDate},
+		{input: "320180-0008", err: personnummer.ErrInvalidDate},
+		{input: "011380-0008", err: personnummer.ErrInvalidDate},
+		{input: "010180-000", err: personnummer.ErrInvalidFormat},
+		{input: "010180+0008", err: personnummer.ErrInvalidFormat},
+		{input: "O10180-0008", err: personnummer.ErrInvalidFormat},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			p, err := NewPerson(tc.input)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "expected %v, got %v", tc.err, err)
+				assert.False(t, IsValidPerson(tc.input))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.date, p.Date.Format("2006-01-02"))
+			assert.Equal(t, tc.gender, p.Gender)
+			assert.Equal(t, tc.gender == personnummer.Male, p.Male())
+			assert.Equal(t, tc.gender == personnummer.Female, p.Female())
+			assert.Equal(t, tc.string, p.String())
+			assert.Equal(t, tc.mod11, p.ValidMod11())
+			assert.True(t, p.Valid())
+			assert.True(t, IsValidPerson(tc.input))
+
+			p, err = NewPersonWithOptions(tc.input, Options{Mod11: true})
+			require.NoError(t, err)
+
+			if tc.mod11 {
+				assert.NoError(t, p.Validate())
+			} else {
+				assert.Equal(t, personnummer.ErrInvalidChecksum, p.Validate())
+			}
+		})
+	}
+}
+
+func TestCentury(t *testing.T) {
+	cases := []struct {
+		digit, year, century int
+	}{
+		{digit: 0, year: 0, century: 1900},
+		{digit: 3, year: 99, century: 1900},
+		{digit: 4, year: 36, century: 2000},
+		{digit: 4, year: 37, century: 1900},
+		{digit: 9, year: 0, century: 2000},
+		{digit: 9, year: 99, century: 1900},
+		{digit: 5, year: 57, century: 2000},
+		{digit: 8, year: 58, century: 1800},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.century, Century(tc.digit, tc.year), "digit %d, year %d", tc.digit, tc.year)
+	}
+}
+
+func TestPerson_DefaultLocation(t *testing.T) {
+	// 23:30 UTC on the 31st of December is already the 1st of January in
+	// Europe/Copenhagen.
+	now := time.Date(2019, 12, 31, 23, 30, 0, 0, time.UTC)
+
+	p, err := NewPerson("010180-0008")
+	require.NoError(t, err)
+
+	assert.Equal(t, 40, p.AgeAt(now))
+
+	p, err = NewPersonWithOptions("010180-0008", Options{Location: time.UTC})
+	require.NoError(t, err)
+
+	assert.Equal(t, 39, p.AgeAt(now))
+}
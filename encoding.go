@@ -0,0 +1,262 @@
+package personnummer
+
+import (
+	"encoding"
+
+	"github.com/bombsimon/go-personnummer/internal/codec"
+)
+
+// The formats used when marshaling types to text or JSON. Use Formatted to
+// marshal a value in another format.
+const (
+	PersonMarshalFormat       = FormatLong
+	OrganizationMarshalFormat = FormatShort
+)
+
+// Formatter is implemented by the types that can be formatted; Parsed, Person,
+// InterimNumber and Organization.
+type Formatter interface {
+	Format(f Format) string
+}
+
+// Formatted marshals Value in Format instead of the default marshal format for
+// its type, e.g. to write a person in the short format.
+//
+//	json.Marshal(Formatted{Value: person, Format: FormatShort})
+//
+// To unmarshal, Value must be a pointer to the type to unmarshal into, such as
+// &Person{}. A nil Value is marshaled as an empty string or JSON null.
+type Formatted struct {
+	Value  Formatter
+	Format Format
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (f Formatted) MarshalText() ([]byte, error) {
+	if f.Value == nil {
+		return []byte{}, nil
+	}
+
+	return []byte(f.Value.Format(f.Format)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler by unmarshaling into
+// Value. ErrInvalidFormat is returned if Value can't be unmarshaled into.
+func (f *Formatted) UnmarshalText(text []byte) error {
+	u, ok := f.Value.(encoding.TextUnmarshaler)
+	if !ok {
+		return ErrInvalidFormat
+	}
+
+	return u.UnmarshalText(text)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f Formatted) MarshalJSON() ([]byte, error) {
+	if f.Value == nil {
+		return []byte("null"), nil
+	}
+
+	return codec.MarshalJSON(f)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both JSON strings and numbers are
+// accepted.
+func (f *Formatted) UnmarshalJSON(data []byte) error {
+	return codec.UnmarshalJSON(data, f)
+}
+
+// Number is a lightweight value type for a valid number of any kind supported
+// by Identify. When unmarshaled the input is validated and stored in the
+// format used for marshaling.
+type Number string
+
+// Identify identifies the number, see Identify.
+func (n Number) Identify() (Identity, error) {
+	return Identify(string(n))
+}
+
+// MarshalText implements encoding.TextMarshaler. An error is returned if the
+// number isn't valid.
+func (n Number) MarshalText() ([]byte, error) {
+	if n == "" {
+		return []byte{}, nil
+	}
+
+	identity, err := n.Identify()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(identity.marshalFormat()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. An error is returned if
+// the text isn't a valid number.
+func (n *Number) UnmarshalText(text []byte) error {
+	identity, err := Identify(string(text))
+	if err != nil {
+		return err
+	}
+
+	*n = Number(identity.marshalFormat())
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n Number) MarshalJSON() ([]byte, error) {
+	if n == "" {
+		return []byte("null"), nil
+	}
+
+	return codec.MarshalJSON(n)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both JSON strings and numbers are
+// accepted.
+func (n *Number) UnmarshalJSON(data []byte) error {
+	return codec.UnmarshalJSON(data, n)
+}
+
+// MarshalText implements encoding.TextMarshaler. The person is formatted with
+// PersonMarshalFormat.
+func (p Person) MarshalText() ([]byte, error) {
+	if p.Parsed == nil {
+		return []byte{}, nil
+	}
+
+	return []byte(p.Format(PersonMarshalFormat)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. An error is returned if
+// the text isn't a valid personal identity number.
+func (p *Person) UnmarshalText(text []byte) error {
+	person, err := NewPerson(string(text))
+	if err != nil {
+		return err
+	}
+
+	if err := person.Validate(); err != nil {
+		return err
+	}
+
+	*p = *person
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p Person) MarshalJSON() ([]byte, error) {
+	if p.Parsed == nil {
+		return []byte("null"), nil
+	}
+
+	return codec.MarshalJSON(p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both JSON strings and numbers are
+// accepted.
+func (p *Person) UnmarshalJSON(data []byte) error {
+	return codec.UnmarshalJSON(data, p)
+}
+
+// MarshalText implements encoding.TextMarshaler. The organization is formatted
+// with OrganizationMarshalFormat.
+func (o Organization) MarshalText() ([]byte, error) {
+	if o.Parsed == nil {
+		return []byte{}, nil
+	}
+
+	return []byte(o.Format(OrganizationMarshalFormat)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. An error is returned if
+// the text isn't a valid organization number.
+func (o *Organization) UnmarshalText(text []byte) error {
+	org, err := NewOrganization(string(text))
+	if err != nil {
+		return err
+	}
+
+	if err := org.Validate(); err != nil {
+		return err
+	}
+
+	*o = *org
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o Organization) MarshalJSON() ([]byte, error) {
+	if o.Parsed == nil {
+		return []byte("null"), nil
+	}
+
+	return codec.MarshalJSON(o)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both JSON strings and numbers are
+// accepted.
+func (o *Organization) UnmarshalJSON(data []byte) error {
+	return codec.UnmarshalJSON(data, o)
+}
+
+// MarshalText implements encoding.TextMarshaler. The interim number is
+// formatted with PersonMarshalFormat, keeping the letter or prefix that tells
+// it apart from a personal identity number.
+func (i InterimNumber) MarshalText() ([]byte, error) {
+	if i.Person == nil {
+		return []byte{}, nil
+	}
+
+	return []byte(i.Format(PersonMarshalFormat)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. An error is returned if
+// the text isn't a valid interim number.
+func (i *InterimNumber) UnmarshalText(text []byte) error {
+	in, err := NewInterimNumber(string(text))
+	if err != nil {
+		return err
+	}
+
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	*i = *in
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i InterimNumber) MarshalJSON() ([]byte, error) {
+	if i.Person == nil {
+		return []byte("null"), nil
+	}
+
+	return codec.MarshalJSON(i)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both JSON strings and numbers are
+// accepted.
+func (i *InterimNumber) UnmarshalJSON(data []byte) error {
+	return codec.UnmarshalJSON(data, i)
+}
+
+// marshalFormat returns the identified number formatted with the marshal
+// format for its kind.
+func (i Identity) marshalFormat() string {
+	switch {
+	case i.Organization != nil:
+		return i.Organization.Format(OrganizationMarshalFormat)
+	case i.Interim != nil:
+		return i.Interim.Format(PersonMarshalFormat)
+	case i.Kind == KindSoleTrader:
+		return i.Person.Format(FormatOrgWithPrefix16)
+	default:
+		return i.Person.Format(PersonMarshalFormat)
+	}
+}
@@ -0,0 +1,216 @@
+package personnummer
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerson_JSON(t *testing.T) {
+	type customer struct {
+		Person *Person `json:"person"`
+	}
+
+	cases := []struct {
+		description string
+		input       string
+		expected    string
+		wantErr     error
+	}{
+		{
+			description: "string",
+			input:       `{"person":"800101-3294"}`,
+			expected:    `{"person":"198001013294"}`,
+		},
+		{
+			description: "number",
+			input:       `{"person":198001013294}`,
+			expected:    `{"person":"198001013294"}`,
+		},
+		{
+			description: "null",
+			input:       `{"person":null}`,
+			expected:    `{"person":null}`,
+		},
+		{
+			description: "invalid format",
+			input:       `{"person":"😸"}`,
+			wantErr:     ErrInvalidFormat,
+		},
+		{
+			description: "invalid checksum",
+			input:       `{"person":"800101-3295"}`,
+			wantErr:     ErrInvalidChecksum,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			var c customer
+
+			err := json.Unmarshal([]byte(tc.input), &c)
+			if tc.wantErr != nil {
+				assert.True(t, errors.Is(err, tc.wantErr), "got %v", err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			output, err := json.Marshal(c)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, string(output))
+		})
+	}
+}
+
+func TestOrganization_JSON(t *testing.T) {
+	var org Organization
+
+	require.NoError(t, json.Unmarshal([]byte(`"16556703-7485"`), &org))
+
+	output, err := json.Marshal(org)
+	require.NoError(t, err)
+
+	assert.Equal(t, `"556703-7485"`, string(output))
+
+	err = json.Unmarshal([]byte(`"800101-3294"`), &org)
+	assert.True(t, errors.Is(err, ErrInvalidThirdDigit))
+}
+
+func TestInterimNumber_JSON(t *testing.T) {
+	type patient struct {
+		Number *InterimNumber `json:"number"`
+	}
+
+	cases := []struct {
+		input    string
+		expected string
+		wantErr  error
+	}{
+		{input: `{"number":"800101-T124"}`, expected: `{"number":"19800101T124"}`},
+		{input: `{"number":"19800101-K124"}`, expected: `{"number":"19800101K124"}`},
+		{input: `{"number":"99800101-3294"}`, expected: `{"number":"998001013294"}`},
+		{input: `{"number":null}`, expected: `{"number":null}`},
+		{input: `{"number":"800101-T125"}`, wantErr: ErrInvalidChecksum},
+		{input: `{"number":"800101-3294"}`, wantErr: ErrInvalidFormat},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			var p patient
+
+			err := json.Unmarshal([]byte(tc.input), &p)
+			if tc.wantErr != nil {
+				assert.True(t, errors.Is(err, tc.wantErr), "got %v", err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			output, err := json.Marshal(p)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, string(output))
+
+			// The marshaled number must unmarshal to the same number.
+			var roundTrip patient
+
+			require.NoError(t, json.Unmarshal(output, &roundTrip))
+
+			if p.Number == nil {
+				assert.Nil(t, roundTrip.Number)
+
+				return
+			}
+
+			require.NotNil(t, roundTrip.Number)
+			assert.Equal(t, p.Number.Format(FormatLong), roundTrip.Number.Format(FormatLong))
+			assert.Equal(t, p.Number.Region, roundTrip.Number.Region)
+		})
+	}
+}
+
+func TestInterimNumber_Text(t *testing.T) {
+	in, err := NewInterimNumber("19800101-T124")
+	require.NoError(t, err)
+
+	text, err := in.MarshalText()
+	require.NoError(t, err)
+
+	assert.Equal(t, "19800101T124", string(text))
+
+	var roundTrip InterimNumber
+
+	require.NoError(t, roundTrip.UnmarshalText(text))
+	assert.Equal(t, in.Format(FormatLong), roundTrip.Format(FormatLong))
+	assert.Equal(t, 'T', roundTrip.Letter)
+}
+
+func TestNumber_JSON(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{input: `"800101-3294"`, expected: `"198001013294"`},
+		{input: `"556703-7485"`, expected: `"556703-7485"`},
+		{input: `"16800101-3294"`, expected: `"168001013294"`},
+		{input: `"800101-T124"`, expected: `"19800101T124"`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			var n Number
+
+			require.NoError(t, json.Unmarshal([]byte(tc.input), &n))
+
+			output, err := json.Marshal(n)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, string(output))
+		})
+	}
+
+	_, err := json.Marshal(Number("800101-3295"))
+	assert.Error(t, err)
+}
+
+func TestFormatted_JSON(t *testing.T) {
+	person, err := NewPerson("19800101-3294")
+	require.NoError(t, err)
+
+	org, err := NewOrganization("556703-7485")
+	require.NoError(t, err)
+
+	type payload struct {
+		Person       Formatted `json:"person"`
+		Organization Formatted `json:"organization"`
+		Empty        Formatted `json:"empty"`
+	}
+
+	output, err := json.Marshal(payload{
+		Person:       Formatted{Value: person, Format: FormatShort},
+		Organization: Formatted{Value: org, Format: FormatLong},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"person":"800101-3294","organization":"165567037485","empty":null}`, string(output))
+
+	var decoded Person
+
+	in := payload{Person: Formatted{Value: &decoded, Format: FormatShort}}
+	require.NoError(t, json.Unmarshal([]byte(`{"person":"198001013294"}`), &in))
+
+	assert.Equal(t, "800101-3294", decoded.String())
+
+	err = json.Unmarshal([]byte(`{"person":"198001013295"}`), &in)
+	assert.True(t, errors.Is(err, ErrInvalidChecksum))
+
+	err = json.Unmarshal([]byte(`{"organization":"556703-7485"}`), &payload{})
+	assert.True(t, errors.Is(err, ErrInvalidFormat))
+}
@@ -0,0 +1,107 @@
+package personnummer
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned when parsing or validating. Validation errors are returned
+// wrapped in a ValidationError which may be inspected with errors.Is or
+// errors.As.
+// nolint: gochecknoglobal
+var (
+	ErrInvalidFormat          = errors.New("invalid format")
+	ErrInvalidChecksum        = errors.New("invalid checksum")
+	ErrMissingControlDigit    = errors.New("missing control digit")
+	ErrInvalidDate            = errors.New("invalid date")
+	ErrInvalidCoordinationDay = errors.New("coordination day out of range")
+	ErrInvalidThirdDigit      = errors.New("third digit must be 2 or higher")
+	ErrInvalidFirstDigit      = errors.New("first digit may not be 0")
+	ErrInvalidDivider         = errors.New("invalid divider")
+	ErrInvalidCentury         = errors.New("invalid century")
+	ErrInvalidSerial          = errors.New("invalid serial")
+	ErrInvalidGender          = errors.New("invalid gender")
+	ErrInvalidCorporateForm   = errors.New("invalid corporate form")
+	ErrNoTestNumbers          = errors.New("no test numbers matching filter")
+	ErrEmptyTestNumbers       = errors.New("no test numbers in set, run go generate to download them")
+	ErrInvalidDateRange       = errors.New("invalid date range")
+	ErrInvalidVATNumber       = errors.New("invalid VAT number")
+	ErrScanNull               = errors.New("cannot scan NULL value")
+	ErrInvalidColumn          = errors.New("invalid column")
+)
+
+// Positions of the different parts in the long form of a number,
+// CCYYMMDD-NNNC. These are used as the Position in a ValidationError.
+const (
+	PositionCentury      = 0
+	PositionYear         = 2
+	PositionMonth        = 4
+	PositionDay          = 6
+	PositionDivider      = 8
+	PositionSerial       = 9
+	PositionControlDigit = 12
+)
+
+// ValidationError describes which rule a number failed to validate against.
+// The Reason is one of the exported errors and Position is the index of the
+// failing part in the long form of the number, CCYYMMDD-NNNC. Offset is the
+// index of the character of the failing part in the input as it was given,
+// which differs from Position if the input was given without century or
+// divider or, in ModeLenient, with characters that were removed or replaced.
+// Expected and Got holds the expected and actual value when applicable.
+type ValidationError struct {
+	Reason   error
+	Position int
+	Offset   int
+	Expected string
+	Got      string
+}
+
+// Error implements the error interface. The position in the message is the
+// offset in the input.
+func (e *ValidationError) Error() string {
+	msg := fmt.Sprintf("%s at position %d", e.Reason, e.Offset)
+
+	if e.Expected != "" || e.Got != "" {
+		msg += fmt.Sprintf(": expected %s, got %s", e.Expected, e.Got)
+	}
+
+	return msg
+}
+
+// Unwrap returns the reason for the validation error.
+func (e *ValidationError) Unwrap() error {
+	return e.Reason
+}
+
+// validationError returns a ValidationError for the failing part at position
+// in the long form with the offset in the input the parsed value came from.
+func (p *Parsed) validationError(reason error, position int, expected, got string) *ValidationError {
+	return &ValidationError{
+		Reason:   reason,
+		Position: position,
+		Offset:   p.offset(position),
+		Expected: expected,
+		Got:      got,
+	}
+}
+
+// offset returns the index in the parsed input for the position in the long
+// form. A missing century or divider moves the following parts to the left, a
+// missing century is reported at the start of the input.
+func (p *Parsed) offset(position int) int {
+	offset := position
+
+	if !p.hasCentury {
+		offset -= PositionYear
+		if offset < 0 {
+			offset = 0
+		}
+	}
+
+	if !p.hasDivider && position > PositionDivider {
+		offset--
+	}
+
+	return inputOffset(p.inputOffsets, offset)
+}
@@ -0,0 +1,201 @@
+package personnummer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerson_Validate(t *testing.T) {
+	cases := []struct {
+		description string
+		input       string
+		reason      error
+		position    int
+		offset      int
+		expected    string
+		got         string
+	}{
+		{
+			description: "valid",
+			input:       "19800101-3294",
+		},
+		{
+			description: "invalid checksum",
+			input:       "19800101-3295",
+			reason:      ErrInvalidChecksum,
+			position:    PositionControlDigit,
+			offset:      12,
+			expected:    "4",
+			got:         "5",
+		},
+		{
+			description: "invalid checksum without century",
+			input:       "800101-3295",
+			reason:      ErrInvalidChecksum,
+			position:    PositionControlDigit,
+			offset:      10,
+			expected:    "4",
+			got:         "5",
+		},
+		{
+			description: "invalid checksum without century and divider",
+			input:       "8001013295",
+			reason:      ErrInvalidChecksum,
+			position:    PositionControlDigit,
+			offset:      9,
+			expected:    "4",
+			got:         "5",
+		},
+		{
+			description: "invalid month",
+			input:       "19801301-3294",
+			reason:      ErrInvalidDate,
+			position:    PositionMonth,
+			offset:      4,
+			got:         "13",
+		},
+		{
+			description: "invalid day",
+			input:       "800230-3294",
+			reason:      ErrInvalidDate,
+			position:    PositionDay,
+			offset:      4,
+			got:         "30",
+		},
+		{
+			description: "organization century prefix",
+			input:       "16800101-3294",
+			reason:      ErrInvalidCentury,
+			position:    PositionCentury,
+			offset:      0,
+			expected:    "not 16",
+			got:         "16",
+		},
+		{
+			description: "coordination day out of range",
+			input:       "19800292-3294",
+			reason:      ErrInvalidCoordinationDay,
+			position:    PositionDay,
+			offset:      6,
+			got:         "92",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			parsed, err := Parse(tc.input)
+			require.NoError(t, err)
+
+			person := &Person{Parsed: parsed}
+			err = person.Validate()
+
+			if tc.reason == nil {
+				assert.NoError(t, err)
+				assert.True(t, person.Valid())
+
+				return
+			}
+
+			var vErr *ValidationError
+
+			require.True(t, errors.As(err, &vErr))
+			assert.True(t, errors.Is(err, tc.reason))
+			assert.Equal(t, tc.position, vErr.Position)
+			assert.Equal(t, tc.offset, vErr.Offset)
+			assert.Equal(t, tc.expected, vErr.Expected)
+			assert.Equal(t, tc.got, vErr.Got)
+			assert.False(t, person.Valid())
+		})
+	}
+}
+
+func TestOrganization_Validate(t *testing.T) {
+	cases := []struct {
+		description string
+		input       string
+		reason      error
+		position    int
+		offset      int
+	}{
+		{
+			description: "valid",
+			input:       "16556703-7485",
+		},
+		{
+			description: "wrong century prefix",
+			input:       "19556703-7485",
+			reason:      ErrInvalidCentury,
+			position:    PositionCentury,
+			offset:      0,
+		},
+		{
+			description: "third digit less than 2",
+			input:       "8001013294",
+			reason:      ErrInvalidThirdDigit,
+			position:    PositionMonth,
+			offset:      2,
+		},
+		{
+			description: "plus divider",
+			input:       "556703+7485",
+			reason:      ErrInvalidDivider,
+			position:    PositionDivider,
+			offset:      6,
+		},
+		{
+			description: "leading zero",
+			input:       "056703-7486",
+			reason:      ErrInvalidFirstDigit,
+			position:    PositionYear,
+			offset:      0,
+		},
+		{
+			description: "invalid checksum",
+			input:       "556703-7486",
+			reason:      ErrInvalidChecksum,
+			position:    PositionControlDigit,
+			offset:      10,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			org, err := NewOrganization(tc.input)
+			require.NoError(t, err)
+
+			err = org.Validate()
+
+			if tc.reason == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			var vErr *ValidationError
+
+			require.True(t, errors.As(err, &vErr))
+			assert.True(t, errors.Is(err, tc.reason))
+			assert.Equal(t, tc.position, vErr.Position)
+			assert.Equal(t, tc.offset, vErr.Offset)
+		})
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	err := &ValidationError{
+		Reason:   ErrInvalidChecksum,
+		Position: PositionControlDigit,
+		Offset:   10,
+		Expected: "4",
+		Got:      "5",
+	}
+
+	assert.Equal(t, "invalid checksum at position 10: expected 4, got 5", err.Error())
+	assert.True(t, errors.Is(err, ErrInvalidChecksum))
+
+	_, err2 := Parse("😸")
+	assert.True(t, errors.Is(err2, ErrInvalidFormat))
+}
@@ -0,0 +1,74 @@
+package fi
+
+import (
+	"math/rand"
+	"time"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+	"github.com/bombsimon/go-personnummer/internal/national"
+)
+
+// nolint: gochecknoglobal
+var defaultGenerator = NewGenerator(nil)
+
+// Generator generates valid personal identity codes from its own random source.
+// Two generators created with the same seed will generate the same codes in
+// the same order. A Generator is safe for concurrent use and the zero value
+// uses a source seeded with the current time.
+type Generator struct {
+	rand national.Rand
+}
+
+// NewGenerator returns a new Generator using the passed source, or a source
+// seeded with the current time if nil.
+func NewGenerator(src rand.Source) *Generator {
+	return &Generator{
+		rand: national.Rand{Source: src},
+	}
+}
+
+// NewGeneratorWithSeed returns a new Generator using a source with the passed
+// seed.
+func NewGeneratorWithSeed(seed int64) *Generator {
+	return NewGenerator(rand.NewSource(seed))
+}
+
+// Generate will generate a valid Finnish personal identity code based on the
+// passed date and gender.
+func Generate(date time.Time, gender personnummer.Gender) (*Person, error) {
+	return defaultGenerator.Person(date, gender)
+}
+
+// Person will generate a valid Finnish personal identity code based on the
+// passed date and gender. The primary century sign is used and the individual
+// number is never a temporary one. Only dates between 1800 and 2099 can be
+// generated.
+func (g *Generator) Person(date time.Time, gender personnummer.Gender) (*Person, error) {
+	if gender != personnummer.Male && gender != personnummer.Female {
+		return nil, personnummer.ErrInvalidGender
+	}
+
+	century := date.Year() / 100 * 100
+	if century < 1800 || century > 2000 {
+		return nil, personnummer.ErrInvalidDate
+	}
+
+	// Pick an individual number between 2 and 899 with the parity of the
+	// gender.
+	individual := minIndividual + 2*g.rand.Intn((minTemporaryIndividual-minIndividual)/2)
+	if gender == personnummer.Male {
+		individual++
+	}
+
+	parsed := &Parsed{
+		Day:         date.Day(),
+		Month:       int(date.Month()),
+		Year:        date.Year() - century,
+		CenturySign: primaryCenturySign(century),
+		Individual:  individual,
+	}
+
+	parsed.ControlCharacter = parsed.Mod31ControlCharacter()
+
+	return NewPersonFromParsed(parsed)
+}
@@ -0,0 +1,44 @@
+package fi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+func TestGenerate(t *testing.T) {
+	dates := []time.Time{
+		time.Date(1850, 6, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1952, 10, 13, 0, 0, 0, 0, time.UTC),
+		time.Date(2004, 2, 29, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, date := range dates {
+		for _, gender := range []personnummer.Gender{personnummer.Male, personnummer.Female} {
+			p, err := Generate(date, gender)
+			require.NoError(t, err)
+
+			assert.True(t, p.Valid(), p.String())
+			assert.Equal(t, date, p.Date)
+			assert.Equal(t, gender, p.Gender)
+			assert.False(t, p.IsTemporary)
+			assert.Equal(t, p.String(), p.Format(FormatPrimarySign))
+			assert.True(t, IsValidPerson(p.String()))
+		}
+	}
+}
+
+func TestGenerate_Invalid(t *testing.T) {
+	_, err := Generate(time.Date(1799, 1, 1, 0, 0, 0, 0, time.UTC), personnummer.Male)
+	assert.Equal(t, personnummer.ErrInvalidDate, err)
+
+	_, err = Generate(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), personnummer.Male)
+	assert.Equal(t, personnummer.ErrInvalidDate, err)
+
+	_, err = Generate(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), personnummer.Gender(3))
+	assert.Equal(t, personnummer.ErrInvalidGender, err)
+}
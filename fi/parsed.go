@@ -0,0 +1,133 @@
+// Package fi parses, validates and generates Finnish personal identity codes
+// (henkilötunnus, HETU).
+//
+// A personal identity code is eleven characters, DDMMYYCZZZQ, where DDMMYY is
+// the date of birth, C is a century sign, ZZZ is an individual number and Q is
+// a control character calculated with mod 31. The individual number is odd for
+// males and even for females and numbers from 900 are temporary.
+//
+// Since 2023 additional century signs are used, B-F for the 2000s and U-Y for
+// the 1900s, next to the primary signs +, - and A.
+package fi
+
+import (
+	"strconv"
+	"strings"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+// controlCharacters are the control characters indexed by the remainder.
+const controlCharacters = "0123456789ABCDEFHJKLMNPRSTUVWXY"
+
+// CenturySign represents the sign between the date of birth and the individual
+// number telling the century.
+type CenturySign string
+
+// The primary century signs. These are always used for generated numbers and
+// formatted with FormatPrimarySign.
+const (
+	CenturySign1800 CenturySign = "+"
+	CenturySign1900 CenturySign = "-"
+	CenturySign2000 CenturySign = "A"
+)
+
+// centurySigns holds all century signs and the century they represent.
+// nolint: gochecknoglobal
+var centurySigns = map[CenturySign]int{
+	"+": 1800,
+	"-": 1900, "Y": 1900, "X": 1900, "W": 1900, "V": 1900, "U": 1900,
+	"A": 2000, "B": 2000, "C": 2000, "D": 2000, "E": 2000, "F": 2000,
+}
+
+// Century returns the century the sign represents or 0 if it's not a valid
+// century sign.
+func (c CenturySign) Century() int {
+	return centurySigns[c]
+}
+
+// primaryCenturySign returns the primary sign for the century.
+func primaryCenturySign(century int) CenturySign {
+	switch century {
+	case 1800:
+		return CenturySign1800
+	case 2000:
+		return CenturySign2000
+	default:
+		return CenturySign1900
+	}
+}
+
+// Parsed represents a parsed personal identity code.
+type Parsed struct {
+	Day              int
+	Month            int
+	Year             int
+	CenturySign      CenturySign
+	Individual       int
+	ControlCharacter byte
+}
+
+// Parse will parse a string and return a pointer to a Parsed type. Letters may
+// be in lower case. If the string isn't in a valid format or has an unknown
+// century sign an error will be returned.
+func Parse(input string) (*Parsed, error) {
+	nr := strings.ToUpper(strings.TrimSpace(input))
+	if len(nr) != 11 {
+		return nil, personnummer.ErrInvalidFormat
+	}
+
+	for _, i := range []int{0, 1, 2, 3, 4, 5, 7, 8, 9} {
+		if nr[i] < '0' || nr[i] > '9' {
+			return nil, personnummer.ErrInvalidFormat
+		}
+	}
+
+	if strings.IndexByte(controlCharacters, nr[10]) < 0 {
+		return nil, personnummer.ErrInvalidFormat
+	}
+
+	sign := CenturySign(nr[6:7])
+	if sign.Century() == 0 {
+		return nil, personnummer.ErrInvalidDivider
+	}
+
+	var (
+		day, _        = strconv.Atoi(nr[0:2])
+		month, _      = strconv.Atoi(nr[2:4])
+		year, _       = strconv.Atoi(nr[4:6])
+		individual, _ = strconv.Atoi(nr[7:10])
+	)
+
+	return &Parsed{
+		Day:              day,
+		Month:            month,
+		Year:             year,
+		CenturySign:      sign,
+		Individual:       individual,
+		ControlCharacter: nr[10],
+	}, nil
+}
+
+// Mod31ControlCharacter calculates the control character, the nine digit
+// number DDMMYYZZZ modulo 31 used as index in 0123456789ABCDEFHJKLMNPRSTUVWXY.
+func (p *Parsed) Mod31ControlCharacter() byte {
+	n := ((p.Day*100+p.Month)*100+p.Year)*1000 + p.Individual
+
+	return controlCharacters[n%31]
+}
+
+// Valid returns if the control character is correct.
+func (p *Parsed) Valid() bool {
+	return p.Validate() == nil
+}
+
+// Validate returns personnummer.ErrInvalidChecksum if the control character is
+// wrong.
+func (p *Parsed) Validate() error {
+	if p.ControlCharacter != p.Mod31ControlCharacter() {
+		return personnummer.ErrInvalidChecksum
+	}
+
+	return nil
+}
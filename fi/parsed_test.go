@@ -0,0 +1,66 @@
+package fi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		input  string
+		parsed *Parsed
+		valid  bool
+		err    error
+	}{
+		{
+			input:  "131052-308T",
+			parsed: &Parsed{Day: 13, Month: 10, Year: 52, CenturySign: "-", Individual: 308, ControlCharacter: 'T'},
+			valid:  true,
+		},
+		{
+			input:  "131052y308t",
+			parsed: &Parsed{Day: 13, Month: 10, Year: 52, CenturySign: "Y", Individual: 308, ControlCharacter: 'T'},
+			valid:  true,
+		},
+		{
+			input:  "131052-308U",
+			parsed: &Parsed{Day: 13, Month: 10, Year: 52, CenturySign: "-", Individual: 308, ControlCharacter: 'U'},
+			valid:  false,
+		},
+		{input: "131052-308", err: personnummer.ErrInvalidFormat},
+		{input: "131052-3O8T", err: personnummer.ErrInvalidFormat},
+		{input: "131052-308G", err: personnummer.ErrInvalidFormat},
+		{input: "131052G308T", err: personnummer.ErrInvalidDivider},
+		{input: "131052Z308T", err: personnummer.ErrInvalidDivider},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			parsed, err := Parse(tc.input)
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.parsed, parsed)
+			assert.Equal(t, tc.valid, parsed.Valid())
+		})
+	}
+}
+
+func TestCenturySign_Century(t *testing.T) {
+	for sign, century := range map[CenturySign]int{
+		"+": 1800,
+		"-": 1900, "U": 1900, "V": 1900, "W": 1900, "X": 1900, "Y": 1900,
+		"A": 2000, "B": 2000, "C": 2000, "D": 2000, "E": 2000, "F": 2000,
+		"G": 0, "Z": 0, "": 0,
+	} {
+		assert.Equal(t, century, sign.Century(), "sign %q", sign)
+	}
+}
@@ -0,0 +1,149 @@
+package fi
+
+import (
+	"fmt"
+	"time"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+	"github.com/bombsimon/go-personnummer/internal/national"
+)
+
+// Individual numbers from minTemporaryIndividual are temporary and numbers
+// below minIndividual aren't used.
+const (
+	minIndividual          = 2
+	minTemporaryIndividual = 900
+)
+
+// defaultLocation is the location used when calculating age if not set in the
+// options.
+// nolint: gochecknoglobal
+var defaultLocation = national.NewLocation("Europe/Helsinki")
+
+// Format represents the different string representations of a personal
+// identity code.
+type Format int
+
+const (
+	// FormatDefault uses the century sign as parsed.
+	FormatDefault Format = iota
+	// FormatPrimarySign uses the primary century sign, +, - or A.
+	FormatPrimarySign
+)
+
+// Options holds settings used when creating a Person. The zero value is ready
+// to use and calculates age in Europe/Helsinki.
+type Options = national.Options
+
+// birth gives Person the age methods, see national.Birth.
+type birth = national.Birth
+
+// Person represents a parsed string to be used in the context of a person. It
+// has the same age methods as personnummer.Person, such as Age and IsOfAge.
+type Person struct {
+	*Parsed
+	Date        time.Time
+	Gender      personnummer.Gender
+	IsTemporary bool
+
+	birth
+}
+
+// NewPerson parses and returns a pointer to a Person based on the input. An
+// error is returned if the input can't be parsed or the date isn't valid, use
+// Valid or Validate to check the control character.
+func NewPerson(input string) (*Person, error) {
+	return NewPersonWithOptions(input, Options{})
+}
+
+// NewPersonWithOptions works like NewPerson but uses the passed options.
+func NewPersonWithOptions(input string, options Options) (*Person, error) {
+	parsed, err := Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPersonFromParsedWithOptions(parsed, options)
+}
+
+// NewPersonFromParsed returns a pointer to a Person based on a Parsed type.
+func NewPersonFromParsed(parsed *Parsed) (*Person, error) {
+	return NewPersonFromParsedWithOptions(parsed, Options{})
+}
+
+// NewPersonFromParsedWithOptions works like NewPersonFromParsed but uses the
+// passed options.
+func NewPersonFromParsedWithOptions(parsed *Parsed, options Options) (*Person, error) {
+	year := parsed.CenturySign.Century() + parsed.Year
+
+	date := time.Date(year, time.Month(parsed.Month), parsed.Day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != parsed.Day || int(date.Month()) != parsed.Month {
+		return nil, personnummer.ErrInvalidDate
+	}
+
+	gender := personnummer.Female
+	if parsed.Individual%2 == 1 {
+		gender = personnummer.Male
+	}
+
+	return &Person{
+		Parsed:      parsed,
+		Date:        date,
+		Gender:      gender,
+		IsTemporary: parsed.Individual >= minTemporaryIndividual,
+		birth:       national.NewBirth(date, options, defaultLocation),
+	}, nil
+}
+
+// IsValidPerson returns if the input is a valid personal identity code.
+func IsValidPerson(input string) bool {
+	p, err := NewPerson(input)
+	if err != nil {
+		return false
+	}
+
+	return p.Valid()
+}
+
+// Validate returns an error if the individual number isn't used or the control
+// character is wrong.
+func (p *Person) Validate() error {
+	if p.Individual < minIndividual {
+		return personnummer.ErrInvalidSerial
+	}
+
+	return p.Parsed.Validate()
+}
+
+// Valid returns if the personal identity code is valid.
+func (p *Person) Valid() bool {
+	return p.Validate() == nil
+}
+
+// Format returns the personal identity code in the given format.
+func (p *Person) Format(f Format) string {
+	sign := p.CenturySign
+	if f == FormatPrimarySign {
+		sign = primaryCenturySign(p.CenturySign.Century())
+	}
+
+	return fmt.Sprintf(
+		"%02d%02d%02d%s%03d%c",
+		p.Day, p.Month, p.Year, sign, p.Individual, p.ControlCharacter,
+	)
+}
+
+// String returns the personal identity code with the century sign as parsed.
+func (p *Person) String() string {
+	return p.Format(FormatDefault)
+}
+
+// Male returns true if the person is a male.
+func (p *Person) Male() bool {
+	return p.Gender == personnummer.Male
+}
+
+// Female returns true if the person is a female.
+func (p *Person) Female() bool {
+	return p.Gender == personnummer.Female
+}
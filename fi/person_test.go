@@ -0,0 +1,88 @@
+package fi
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+func TestNewPerson(t *testing.T) {
+	cases := []struct {
+		input     string
+		date      string
+		gender    personnummer.Gender
+		temporary bool
+		primary   string
+		valid     bool
+		err       error
+	}{
+		{input: "131052-308T", date: "1952-10-13", gender: personnummer.Female, primary: "131052-308T", valid: true},
+		{input: "131052Y308T", date: "1952-10-13", gender: personnummer.Female, primary: "131052-308T", valid: true},
+		{input: "010180-0025", date: "1980-01-01", gender: personnummer.Female, primary: "010180-0025", valid: true},
+		{input: "010180A0036", date: "2080-01-01", gender: personnummer.Male, primary: "010180A0036", valid: true},
+		{input: "010180B0036", date: "2080-01-01", gender: personnummer.Male, primary: "010180A0036", valid: true},
+		{input: "290204A004F", date: "2004-02-29", gender: personnummer.Female, primary: "290204A004F", valid: true},
+		{input: "010101+002S", date: "1801-01-01", gender: personnummer.Female, primary: "010101+002S", valid: true},
+		{input: "010180-9004", date: "1980-01-01", gender: personnummer.Female, temporary: true, primary: "010180-9004", valid: true},
+		{input: "010180-0012", date: "1980-01-01", gender: personnummer.Male, primary: "010180-0012", valid: false},
+		{input: "131052-308U", date: "1952-10-13", gender: personnummer.Female, primary: "131052-308U", valid: false},
+		{input: "290203A004F", err: personnummer.ErrInvalidDate},
+		{input: "321052-308T", err: personnummer.ErrInvalidDate},
+		{input: "131052G308T", err: personnummer.ErrInvalidDivider},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			p, err := NewPerson(tc.input)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "expected %v, got %v", tc.err, err)
+				assert.False(t, IsValidPerson(tc.input))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.date, p.Date.Format("2006-01-02"))
+			assert.Equal(t, tc.gender, p.Gender)
+			assert.Equal(t, tc.gender == personnummer.Male, p.Male())
+			assert.Equal(t, tc.gender == personnummer.Female, p.Female())
+			assert.Equal(t, tc.temporary, p.IsTemporary)
+			assert.Equal(t, tc.valid, p.Valid())
+			assert.Equal(t, tc.valid, IsValidPerson(tc.input))
+			assert.Equal(t, tc.input, p.String())
+			assert.Equal(t, tc.primary, p.Format(FormatPrimarySign))
+		})
+	}
+}
+
+func TestPerson_Validate(t *testing.T) {
+	// 001 isn't used even if the control character is correct.
+	p, err := NewPerson("010180-0012")
+	require.NoError(t, err)
+
+	p.ControlCharacter = p.Mod31ControlCharacter()
+
+	assert.True(t, p.Parsed.Valid())
+	assert.Equal(t, personnummer.ErrInvalidSerial, p.Validate())
+}
+
+func TestPerson_DefaultLocation(t *testing.T) {
+	// 23:30 UTC on the 31st of December is already the 1st of January in
+	// Europe/Helsinki.
+	now := time.Date(2019, 12, 31, 23, 30, 0, 0, time.UTC)
+
+	p, err := NewPerson("010180-0025")
+	require.NoError(t, err)
+
+	assert.Equal(t, 40, p.AgeAt(now))
+
+	p, err = NewPersonWithOptions("010180-0025", Options{Location: time.UTC})
+	require.NoError(t, err)
+
+	assert.Equal(t, 39, p.AgeAt(now))
+}
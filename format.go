@@ -0,0 +1,148 @@
+package personnummer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Format represents the different string representations of a number.
+type Format int
+
+const (
+	// FormatShort is the ten digit form with divider, YYMMDD-NNNC.
+	FormatShort Format = iota
+	// FormatShortNoDivider is the ten digit form without divider, YYMMDDNNNC.
+	FormatShortNoDivider
+	// FormatLong is the twelve digit form without divider, CCYYMMDDNNNC. This
+	// is the form required by most government APIs.
+	FormatLong
+	// FormatLongWithDivider is the twelve digit form with divider,
+	// CCYYMMDD-NNNC.
+	FormatLongWithDivider
+	// FormatOrgWithPrefix16 is the twelve digit form used for organizations
+	// where the century is replaced with 16, 16YYMMDDNNNC.
+	FormatOrgWithPrefix16
+)
+
+// Format returns the parsed string in the given format. The divider and
+// century are used as parsed, if no century was parsed the long formats will
+// not include one.
+func (p *Parsed) Format(f Format) string {
+	return formatParts(
+		f, p.Century, p.Year, p.Month, p.Day, p.Divider,
+		p.serialString(), p.controlDigit(),
+	)
+}
+
+// Format returns the person in the given format. The century is resolved if
+// not set and the divider in the short format is recalculated, '+' is used if
+// the person is 100 years or older at the reference time. This is the same
+// rule used when resolving the century in SetCentury.
+func (p *Person) Format(f Format) string {
+	return formatParts(
+		f, p.formatCentury(), p.Year, p.Month, p.Day, p.formatDivider(),
+		fmt.Sprintf("%03d", p.Serial), p.controlDigit(),
+	)
+}
+
+// Format returns the interim number in the given format with the same rules as
+// for a Person. Reserve numbers from Region Stockholm are always prefixed with
+// 99 and divided with '-' since the prefix is what tells them apart from a
+// personal identity number.
+func (i *InterimNumber) Format(f Format) string {
+	if i.Region == RegionStockholm {
+		long := formatParts(
+			FormatLongWithDivider, stockholmReserveCentury, i.Year, i.Month, i.Day,
+			DividerMinus, i.serialString(), i.controlDigit(),
+		)
+
+		if f == FormatShortNoDivider || f == FormatLong || f == FormatOrgWithPrefix16 {
+			return strings.Replace(long, string(DividerMinus), "", 1)
+		}
+
+		return long
+	}
+
+	return formatParts(
+		f, i.formatCentury(), i.Year, i.Month, i.Day, i.formatDivider(),
+		i.serialString(), i.controlDigit(),
+	)
+}
+
+// Format returns the organization in the given format. Organizations are
+// always divided with '-' and the long formats are prefixed with 16.
+func (o *Organization) Format(f Format) string {
+	return formatParts(
+		f, 1600, o.Year, o.Month, o.Day, DividerMinus,
+		fmt.Sprintf("%03d", o.Serial), o.controlDigit(),
+	)
+}
+
+// String returns the string representation of an organization.
+func (o *Organization) String() string {
+	return o.Format(FormatShort)
+}
+
+// controlDigit returns the parsed control digit or 0 if not set.
+func (p *Parsed) controlDigit() int {
+	if p.ControlDigit == nil {
+		return 0
+	}
+
+	return *p.ControlDigit
+}
+
+// formatCentury returns the century of the person, resolving it if needed.
+func (p *Person) formatCentury() int {
+	// If the century can't be resolved we use whatever is set.
+	_ = p.SetCentury()
+
+	return p.Century
+}
+
+// formatDivider returns the divider to use in the short format based on the
+// age of the person at the reference time.
+func (p *Person) formatDivider() Divider {
+	if err := p.SetDate(); err != nil {
+		return p.Divider
+	}
+
+	return dividerAt(p.Date, p.options.now())
+}
+
+// dividerAt returns the divider to use for someone born at the passed date at
+// time t, '+' if 100 years or older and '-' otherwise.
+func dividerAt(date, t time.Time) Divider {
+	if date.AddDate(100, 0, 0).After(t) {
+		return DividerMinus
+	}
+
+	return DividerPlus
+}
+
+// formatParts formats the parts of a number in the given format.
+func formatParts(f Format, century, year, month, day int, divider Divider, serial string, cd int) string {
+	short := fmt.Sprintf("%02d%02d%02d", year, month, day)
+	end := fmt.Sprintf("%s%d", serial, cd)
+
+	centuryPrefix := ""
+	if century > 0 {
+		centuryPrefix = fmt.Sprintf("%02d", century/100)
+	}
+
+	switch f {
+	case FormatShort:
+		return short + string(divider) + end
+	case FormatShortNoDivider:
+		return short + end
+	case FormatLong:
+		return centuryPrefix + short + end
+	case FormatLongWithDivider:
+		return centuryPrefix + short + string(DividerMinus) + end
+	case FormatOrgWithPrefix16:
+		return "16" + short + end
+	}
+
+	return short + string(divider) + end
+}
@@ -0,0 +1,100 @@
+package personnummer
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerson_Format(t *testing.T) {
+	now := func() time.Time {
+		return time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	cases := []struct {
+		input    string
+		format   Format
+		expected string
+	}{
+		{input: "8001013294", format: FormatShort, expected: "800101-3294"},
+		{input: "8001013294", format: FormatShortNoDivider, expected: "8001013294"},
+		{input: "8001013294", format: FormatLong, expected: "198001013294"},
+		{input: "8001013294", format: FormatLongWithDivider, expected: "19800101-3294"},
+		{input: "8001013294", format: FormatOrgWithPrefix16, expected: "168001013294"},
+		{input: "19220101-3294", format: FormatShort, expected: "220101+3294"},
+		{input: "19230101-3294", format: FormatShort, expected: "230101-3294"},
+		{input: "19220601-3294", format: FormatShort, expected: "220601+3294"},
+		{input: "19220602-3294", format: FormatShort, expected: "220602-3294"},
+		{input: "800101+3294", format: FormatShort, expected: "800101+3294"},
+		{input: "800101+3294", format: FormatLong, expected: "188001013294"},
+		{input: "180377-2381", format: FormatLong, expected: "201803772381"},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%s in format %d", tc.input, tc.format), func(t *testing.T) {
+			person, err := NewPersonWithOptions(tc.input, Options{Now: now})
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, person.Format(tc.format))
+
+			if tc.format == FormatShort {
+				assert.Equal(t, tc.expected, person.String())
+			}
+		})
+	}
+}
+
+func TestPerson_String(t *testing.T) {
+	person, err := NewPerson("800161-3291")
+	require.NoError(t, err)
+
+	assert.Equal(t, "800161-3291", person.String())
+	assert.True(t, IsValidPerson(person.String()))
+}
+
+func TestOrganization_Format(t *testing.T) {
+	org, err := NewOrganization("556703-7485")
+	require.NoError(t, err)
+
+	assert.Equal(t, "556703-7485", org.String())
+	assert.Equal(t, "556703-7485", org.Format(FormatShort))
+	assert.Equal(t, "5567037485", org.Format(FormatShortNoDivider))
+	assert.Equal(t, "165567037485", org.Format(FormatLong))
+	assert.Equal(t, "16556703-7485", org.Format(FormatLongWithDivider))
+	assert.Equal(t, "165567037485", org.Format(FormatOrgWithPrefix16))
+}
+
+func TestParsed_Format(t *testing.T) {
+	parsed, err := Parse("8001013294")
+	require.NoError(t, err)
+
+	assert.Equal(t, "800101-3294", parsed.Format(FormatShort))
+	assert.Equal(t, "8001013294", parsed.Format(FormatLong))
+
+	parsed, err = Parse("19800101+3294")
+	require.NoError(t, err)
+
+	assert.Equal(t, "800101+3294", parsed.Format(FormatShort))
+	assert.Equal(t, "19800101-3294", parsed.Format(FormatLongWithDivider))
+}
+
+func TestInterimNumber_Format(t *testing.T) {
+	in, err := NewInterimNumber("800101-T124")
+	require.NoError(t, err)
+
+	assert.Equal(t, "19800101-T124", in.Format(FormatLongWithDivider))
+	assert.Equal(t, "19800101T124", in.Format(FormatLong))
+	assert.Equal(t, "800101-T124", in.Parsed.Format(FormatShort))
+
+	// The 99 prefix is kept in all formats.
+	in, err = NewInterimNumber("99800101-3294")
+	require.NoError(t, err)
+
+	assert.Equal(t, "99800101-3294", in.Format(FormatShort))
+	assert.Equal(t, "998001013294", in.Format(FormatShortNoDivider))
+	assert.Equal(t, "998001013294", in.Format(FormatLong))
+	assert.Equal(t, "99800101-3294", in.Format(FormatLongWithDivider))
+}
@@ -0,0 +1,401 @@
+package personnummer
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// secondsPerDay is used to count the days between two dates at midnight UTC. A
+// time.Duration can't be used since it only holds about 292 years.
+const secondsPerDay = 24 * 60 * 60
+
+// nolint: gochecknoglobal
+var (
+	defaultGenerator = NewGenerator(nil)
+
+	// The birth date range used by AnyPerson if no limits are set.
+	defaultMinBirthDate = time.Date(1974, 1, 1, 0, 0, 0, 0, time.UTC)
+	defaultMaxBirthDate = time.Date(2013, 12, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// GeneratorOptions holds settings used when creating a Generator. The zero
+// value is ready to use and generates people born between 1974 and 2013 where
+// half are female.
+type GeneratorOptions struct {
+	// MinBirthDate and MaxBirthDate limits the birth date of people generated
+	// by AnyPerson, both inclusive.
+	MinBirthDate time.Time
+	MaxBirthDate time.Time
+
+	// MinAge and MaxAge limits the age at the reference time of people
+	// generated by AnyPerson, both inclusive. Zero means no limit.
+	//
+	// If both birth dates and ages are set the intersection is used. If no
+	// limit at all is set people born between 1974 and 2013 are generated.
+	// If only a lower or upper limit is set the other one is 100 years from
+	// it, or the reference time if that's earlier.
+	MinAge int
+	MaxAge int
+
+	// Genders limits the genders of people generated by AnyPerson, empty
+	// means any gender.
+	Genders []Gender
+
+	// FemaleShare is the share, between 0 and 1, of people generated by
+	// AnyPerson that will be female if both genders are allowed. Zero means
+	// half of them.
+	FemaleShare float64
+
+	// Now returns the reference time used for age limits and when deciding if
+	// a generated person is 100 years or older and should be divided with '+'.
+	// Defaults to time.Now.
+	Now func() time.Time
+
+	// OrganizationPrefix sets the century of generated organizations to 16
+	// so they're formatted with the 16 prefix.
+	OrganizationPrefix bool
+
+	// CoordinationShare is the share, between 0 and 1, of people generated by
+	// AnyPerson that will get a coordination number instead of a personal
+	// identity number.
+	CoordinationShare float64
+
+	// OnlyTestNumbers makes AnyPerson only draw numbers from TestNumbers so
+	// no generated number will belong to a real person.
+	OnlyTestNumbers bool
+
+	// TestNumbers is the set used by TestPerson and when OnlyTestNumbers is
+	// set. Defaults to the numbers published by Skatteverket.
+	TestNumbers *TestNumbers
+}
+
+// Generator generates valid numbers from its own random source. Two
+// generators created with the same seed and options will generate the same
+// numbers in the same order which makes it possible to reproduce fixtures. The
+// options can't be changed once the generator is created, create one generator
+// per set of options instead. A Generator is safe for concurrent use and the
+// zero value uses the default options and a random source seeded with the
+// current time.
+type Generator struct {
+	options GeneratorOptions
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewGenerator returns a new Generator using the passed source.
+func NewGenerator(src rand.Source) *Generator {
+	return NewGeneratorWithOptions(src, GeneratorOptions{})
+}
+
+// NewGeneratorWithSeed returns a new Generator using a source with the passed
+// seed.
+func NewGeneratorWithSeed(seed int64) *Generator {
+	return NewGenerator(rand.NewSource(seed))
+}
+
+// NewGeneratorWithOptions returns a new Generator using the passed source and
+// options. A source seeded with the current time is used if src is nil.
+func NewGeneratorWithOptions(src rand.Source, options GeneratorOptions) *Generator {
+	g := &Generator{
+		options: options,
+	}
+
+	if src != nil {
+		g.rand = rand.New(src) // nolint: gosec
+	}
+
+	return g
+}
+
+// Generate will generate a valid Swedish social security number
+// based on passed year, month, day and sex.
+func Generate(date time.Time, sex Gender) (*Person, error) {
+	return defaultGenerator.Person(date, sex)
+}
+
+// GenerateAny will generate a random valid Swedish social security number
+// with a random date and random sex.
+func GenerateAny() (*Person, error) {
+	return defaultGenerator.AnyPerson()
+}
+
+// GenerateCoordination will generate a valid Swedish coordination number based
+// on passed year, month, day and sex.
+func GenerateCoordination(date time.Time, sex Gender) (*Person, error) {
+	return defaultGenerator.Coordination(date, sex)
+}
+
+// GenerateTestNumber will pick a random test personal identity number published
+// by Skatteverket matching the filter.
+func GenerateTestNumber(filter TestNumberFilter) (*Person, error) {
+	return defaultGenerator.TestPerson(filter)
+}
+
+// GenerateOrganization will generate a valid Swedish organization number of
+// the passed corporate form.
+func GenerateOrganization(form CorporateForm) (*Organization, error) {
+	return defaultGenerator.Organization(form)
+}
+
+// GenerateAnyOrganization will generate a valid Swedish organization number of
+// a random corporate form.
+func GenerateAnyOrganization() (*Organization, error) {
+	return defaultGenerator.AnyOrganization()
+}
+
+// Person will generate a valid Swedish social security number based on passed
+// year, month, day and sex.
+func (g *Generator) Person(date time.Time, sex Gender) (*Person, error) {
+	return g.person(date, sex, false)
+}
+
+// Coordination will generate a valid Swedish coordination number based on
+// passed year, month, day and sex. The day in the number is the day of the
+// date + 60.
+func (g *Generator) Coordination(date time.Time, sex Gender) (*Person, error) {
+	return g.person(date, sex, true)
+}
+
+// person generates a person with a personal identity number or coordination
+// number.
+func (g *Generator) person(date time.Time, sex Gender, coordination bool) (*Person, error) {
+	if sex != Male && sex != Female {
+		return nil, ErrInvalidGender
+	}
+
+	sexIndications := map[Gender][]int{
+		Male:   {1, 3, 5, 7, 9},
+		Female: {2, 4, 6, 8, 0},
+	}
+
+	randStart := g.intn(99)
+	randSex := sexIndications[sex][g.intn(len(sexIndications[sex]))]
+	randSerial, _ := strconv.Atoi(fmt.Sprintf("%02d%d", randStart, randSex))
+
+	century := date.Year() / 100 * 100
+	parsed := &Parsed{
+		Century: century,
+		Year:    date.Year() - century,
+		Month:   int(date.Month()),
+		Day:     date.Day(),
+		Serial:  randSerial,
+	}
+
+	if coordination {
+		parsed.Day += minCoordinationNumber
+	}
+
+	parsed.Divider = dividerAt(truncateDate(date), g.now())
+
+	cs := parsed.LuhnChecksum()
+	cd := parsed.LuhnControlDigit(cs)
+
+	parsed.ControlDigit = &cd
+
+	return NewPersonFromParsedWithOptions(parsed, Options{Now: g.options.Now})
+}
+
+// AnyPerson will generate a random valid Swedish social security number with a
+// random date and random sex within the limits set on the generator.
+func (g *Generator) AnyPerson() (*Person, error) {
+	min, max, limited := g.birthDateRange()
+	if max.Before(min) {
+		return nil, ErrInvalidDateRange
+	}
+
+	sex := g.gender()
+
+	if g.options.OnlyTestNumbers {
+		filter := TestNumberFilter{Genders: []Gender{sex}}
+
+		if limited {
+			filter.MinBirthYear = min.Year()
+			filter.MaxBirthYear = max.Year()
+		}
+
+		return g.TestPerson(filter)
+	}
+
+	var (
+		days         = int((max.Unix() - min.Unix()) / secondsPerDay)
+		date         = min.AddDate(0, 0, g.intn(days+1))
+		coordination = g.float64() < g.options.CoordinationShare
+	)
+
+	return g.person(date, sex, coordination)
+}
+
+// birthDateRange returns the range of birth dates to generate people within
+// based on the birth date and age limits. The last return value is false if
+// no limits are set and the default range is used.
+func (g *Generator) birthDateRange() (time.Time, time.Time, bool) {
+	var (
+		now      = g.now()
+		today    = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		min, max time.Time
+	)
+
+	if !g.options.MinBirthDate.IsZero() {
+		min = truncateDate(g.options.MinBirthDate)
+	}
+
+	if !g.options.MaxBirthDate.IsZero() {
+		max = truncateDate(g.options.MaxBirthDate)
+	}
+
+	// To be at most n years old you must be born after the day you would
+	// have turned n + 1.
+	if g.options.MaxAge > 0 {
+		earliest := today.AddDate(-(g.options.MaxAge + 1), 0, 1)
+		if min.IsZero() || earliest.After(min) {
+			min = earliest
+		}
+	}
+
+	if g.options.MinAge > 0 {
+		latest := today.AddDate(-g.options.MinAge, 0, 0)
+		if max.IsZero() || latest.Before(max) {
+			max = latest
+		}
+	}
+
+	switch {
+	case min.IsZero() && max.IsZero():
+		return defaultMinBirthDate, defaultMaxBirthDate, false
+	case min.IsZero():
+		min = max.AddDate(-100, 0, 0)
+	case max.IsZero():
+		max = min.AddDate(100, 0, 0)
+		if today.Before(max) {
+			max = today
+		}
+	}
+
+	return min, max, true
+}
+
+// gender returns a random gender of the ones allowed by the options.
+func (g *Generator) gender() Gender {
+	switch len(g.options.Genders) {
+	case 0:
+	case 1:
+		return g.options.Genders[0]
+	default:
+		return g.options.Genders[g.intn(len(g.options.Genders))]
+	}
+
+	share := g.options.FemaleShare
+	if share == 0 {
+		share = 0.5
+	}
+
+	if g.float64() < share {
+		return Female
+	}
+
+	return Male
+}
+
+// now returns the reference time for the generator.
+func (g *Generator) now() time.Time {
+	if g.options.Now == nil {
+		return time.Now()
+	}
+
+	return g.options.Now()
+}
+
+// truncateDate returns the date of t at midnight UTC.
+func truncateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// TestPerson will pick a random number matching the filter from the
+// generators TestNumbers. ErrNoTestNumbers is returned if no number matches and
+// ErrEmptyTestNumbers if the set is empty, e.g. if the embedded numbers from
+// Skatteverket hasn't been downloaded with go generate.
+func (g *Generator) TestPerson(filter TestNumberFilter) (*Person, error) {
+	tn := g.options.TestNumbers
+	if tn == nil {
+		tn = SkatteverketTestNumbers()
+	}
+
+	if tn.Len() == 0 {
+		return nil, ErrEmptyTestNumbers
+	}
+
+	people := tn.Filter(filter)
+	if len(people) == 0 {
+		return nil, ErrNoTestNumbers
+	}
+
+	// Return a new person so the set can't be modified.
+	return NewPerson(people[g.intn(len(people))].Format(FormatLong))
+}
+
+// Organization will generate a valid Swedish organization number of the passed
+// corporate form. The first digit is the corporate form, the third digit is
+// always 2 or higher and the control digit is calculated with the Luhn
+// algorithm.
+func (g *Generator) Organization(form CorporateForm) (*Organization, error) {
+	if form < CorporateFormEstate || form > CorporateFormTradingPartnershipSimple {
+		return nil, ErrInvalidCorporateForm
+	}
+
+	parsed := &Parsed{
+		Year:    int(form)*10 + g.intn(10),
+		Month:   20 + g.intn(80),
+		Day:     g.intn(100),
+		Serial:  g.intn(1000),
+		Divider: DividerMinus,
+	}
+
+	if g.options.OrganizationPrefix {
+		parsed.Century = 1600
+	}
+
+	cs := parsed.LuhnChecksum()
+	cd := parsed.LuhnControlDigit(cs)
+
+	parsed.ControlDigit = &cd
+
+	return NewOrganizationFromParsed(parsed)
+}
+
+// AnyOrganization will generate a valid Swedish organization number of a
+// random corporate form.
+func (g *Generator) AnyOrganization() (*Organization, error) {
+	form := CorporateForm(g.intn(int(CorporateFormTradingPartnershipSimple)) + 1)
+
+	return g.Organization(form)
+}
+
+// intn returns a random number in [0,n) from the generators source.
+func (g *Generator) intn(n int) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.source().Intn(n)
+}
+
+// float64 returns a random number in [0.0,1.0) from the generators source.
+func (g *Generator) float64() float64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.source().Float64()
+}
+
+// source returns the generators source, creating one seeded with the current
+// time if not set. The lock must be held.
+func (g *Generator) source() *rand.Rand {
+	if g.rand == nil {
+		g.rand = rand.New(rand.NewSource(time.Now().UnixNano())) // nolint: gosec
+	}
+
+	return g.rand
+}
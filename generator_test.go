@@ -0,0 +1,376 @@
+package personnummer
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator_Person(t *testing.T) {
+	g := NewGeneratorWithSeed(1)
+	date := time.Date(1999, 2, 20, 0, 0, 0, 0, time.UTC)
+
+	for _, gender := range []Gender{Male, Female} {
+		for i := 0; i < 100; i++ {
+			person, err := g.Person(date, gender)
+			require.NoError(t, err)
+
+			assert.True(t, person.Valid())
+			assert.Equal(t, date, person.Date)
+			assert.Equal(t, gender, person.Gender)
+		}
+	}
+
+	_, err := g.Person(date, Gender(3))
+	assert.True(t, errors.Is(err, ErrInvalidGender))
+}
+
+func TestGenerator_Reproducible(t *testing.T) {
+	generate := func(g *Generator) []string {
+		numbers := make([]string, 50)
+
+		for i := range numbers {
+			person, err := g.AnyPerson()
+			require.NoError(t, err)
+			require.True(t, person.Valid())
+
+			numbers[i] = person.Format(FormatLong)
+		}
+
+		return numbers
+	}
+
+	first := generate(NewGeneratorWithSeed(42))
+
+	assert.Equal(t, first, generate(NewGeneratorWithSeed(42)))
+	assert.Equal(t, first, generate(NewGenerator(rand.NewSource(42))))
+	assert.NotEqual(t, first, generate(NewGeneratorWithSeed(43)))
+}
+
+func TestGenerateAny(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		person, err := GenerateAny()
+		require.NoError(t, err)
+
+		assert.True(t, person.Valid())
+	}
+}
+
+func TestGenerator_Organization(t *testing.T) {
+	g := NewGeneratorWithSeed(1)
+
+	for form := CorporateFormEstate; form <= CorporateFormTradingPartnershipSimple; form++ {
+		for i := 0; i < 20; i++ {
+			org, err := g.Organization(form)
+			require.NoError(t, err)
+
+			assert.True(t, org.Valid(), org.String())
+			assert.Equal(t, form, org.CorporateForm)
+			assert.Equal(t, 0, org.Century)
+		}
+	}
+
+	_, err := g.Organization(CorporateForm(0))
+	assert.True(t, errors.Is(err, ErrInvalidCorporateForm))
+
+	_, err = g.Organization(CorporateForm(10))
+	assert.True(t, errors.Is(err, ErrInvalidCorporateForm))
+}
+
+func TestGenerator_OrganizationPrefix(t *testing.T) {
+	g := NewGeneratorWithOptions(rand.NewSource(1), GeneratorOptions{OrganizationPrefix: true})
+
+	org, err := g.AnyOrganization()
+	require.NoError(t, err)
+
+	assert.True(t, org.Valid())
+	assert.Equal(t, 1600, org.Century)
+
+	identity, err := Identify(org.Format(FormatLongWithDivider))
+	require.NoError(t, err)
+
+	assert.Equal(t, KindOrganization, identity.Kind)
+}
+
+func TestGenerateAnyOrganization(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		org, err := GenerateAnyOrganization()
+		require.NoError(t, err)
+
+		assert.True(t, org.Valid())
+	}
+
+	org, err := GenerateOrganization(CorporateFormLimitedCompany)
+	require.NoError(t, err)
+
+	assert.Equal(t, CorporateFormLimitedCompany, org.CorporateForm)
+}
+
+func TestGenerator_Coordination(t *testing.T) {
+	g := NewGeneratorWithSeed(1)
+	date := time.Date(2018, 3, 17, 0, 0, 0, 0, time.UTC)
+
+	person, err := g.Coordination(date, Female)
+	require.NoError(t, err)
+
+	assert.True(t, person.Valid())
+	assert.True(t, person.IsCoordination)
+	assert.Equal(t, 77, person.Day)
+	assert.Equal(t, date, person.Date)
+	assert.Equal(t, Female, person.Gender)
+
+	identity, err := Identify(person.Format(FormatLong))
+	require.NoError(t, err)
+
+	assert.Equal(t, KindCoordination, identity.Kind)
+
+	person, err = GenerateCoordination(date, Male)
+	require.NoError(t, err)
+
+	assert.True(t, person.IsCoordination)
+}
+
+func TestGenerator_CoordinationShare(t *testing.T) {
+	cases := []struct {
+		share    float64
+		min, max int
+	}{
+		{share: 0, min: 0, max: 0},
+		{share: 0.3, min: 200, max: 400},
+		{share: 1, min: 1000, max: 1000},
+	}
+
+	for _, tc := range cases {
+		g := NewGeneratorWithOptions(rand.NewSource(1), GeneratorOptions{CoordinationShare: tc.share})
+
+		coordination := 0
+
+		for i := 0; i < 1000; i++ {
+			person, err := g.AnyPerson()
+			require.NoError(t, err)
+			require.True(t, person.Valid())
+
+			if person.IsCoordination {
+				coordination++
+			}
+		}
+
+		assert.True(t, coordination >= tc.min && coordination <= tc.max, "got %d", coordination)
+	}
+}
+
+func TestGenerator_AnyPersonLimits(t *testing.T) {
+	reference := time.Date(2022, 6, 15, 12, 0, 0, 0, time.UTC)
+	now := func() time.Time { return reference }
+
+	cases := []struct {
+		description      string
+		options          GeneratorOptions
+		minDate, maxDate time.Time
+		minAge, maxAge   int
+	}{
+		{
+			description: "default",
+			minDate:     time.Date(1974, 1, 1, 0, 0, 0, 0, time.UTC),
+			maxDate:     time.Date(2013, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "birth dates",
+			options: GeneratorOptions{
+				MinBirthDate: time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC),
+				MaxBirthDate: time.Date(1900, 1, 10, 0, 0, 0, 0, time.UTC),
+			},
+			minDate: time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC),
+			maxDate: time.Date(1900, 1, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "newborns",
+			options: GeneratorOptions{
+				MinBirthDate: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+			},
+			minDate: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+			maxDate: time.Date(2022, 6, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "pensioners and centenarians",
+			options: GeneratorOptions{
+				MinAge: 65,
+				MaxAge: 110,
+			},
+			minAge: 65,
+			maxAge: 110,
+		},
+		{
+			description: "ages and birth dates",
+			options: GeneratorOptions{
+				MinAge:       20,
+				MaxBirthDate: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			minAge:  32,
+			maxDate: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			tc.options.Now = now
+			g := NewGeneratorWithOptions(rand.NewSource(1), tc.options)
+
+			for i := 0; i < 500; i++ {
+				person, err := g.AnyPerson()
+				require.NoError(t, err)
+				require.True(t, person.Valid())
+
+				if !tc.minDate.IsZero() {
+					assert.False(t, person.Date.Before(tc.minDate), person.Date)
+				}
+
+				if !tc.maxDate.IsZero() {
+					assert.False(t, person.Date.After(tc.maxDate), person.Date)
+				}
+
+				// Turned min age and not yet turned max age + 1.
+				assert.False(t, person.Date.AddDate(tc.minAge, 0, 0).After(reference), person.Date)
+
+				if tc.maxAge > 0 {
+					assert.True(t, person.Date.AddDate(tc.maxAge+1, 0, 0).After(reference), person.Date)
+				}
+
+				// The short format must resolve to the same person.
+				short, err := NewPersonWithOptions(person.Format(FormatShort), Options{Now: now})
+				require.NoError(t, err)
+
+				assert.Equal(t, person.Date, short.Date)
+			}
+		})
+	}
+}
+
+func TestGenerator_Centenarian(t *testing.T) {
+	g := NewGeneratorWithOptions(rand.NewSource(1), GeneratorOptions{
+		Now: func() time.Time { return time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC) },
+	})
+
+	person, err := g.Person(time.Date(1922, 1, 1, 0, 0, 0, 0, time.UTC), Male)
+	require.NoError(t, err)
+
+	assert.Equal(t, DividerPlus, person.Divider)
+
+	person, err = g.Person(time.Date(1922, 1, 2, 0, 0, 0, 0, time.UTC), Male)
+	require.NoError(t, err)
+
+	assert.Equal(t, DividerMinus, person.Divider)
+}
+
+func TestGenerator_WideDateRange(t *testing.T) {
+	var (
+		min = time.Date(1700, 1, 1, 0, 0, 0, 0, time.UTC)
+		max = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		g   = NewGeneratorWithOptions(rand.NewSource(1), GeneratorOptions{
+			MinBirthDate: min,
+			MaxBirthDate: max,
+		})
+		earliest, latest = max, min
+	)
+
+	// More than the roughly 292 years a time.Duration can hold.
+	for i := 0; i < 500; i++ {
+		person, err := g.AnyPerson()
+		require.NoError(t, err)
+
+		require.False(t, person.Date.Before(min), person.Date)
+		require.False(t, person.Date.After(max), person.Date)
+
+		if person.Date.Before(earliest) {
+			earliest = person.Date
+		}
+
+		if person.Date.After(latest) {
+			latest = person.Date
+		}
+	}
+
+	assert.True(t, earliest.Year() < 1750, earliest)
+	assert.True(t, latest.Year() > 1992, latest)
+}
+
+func TestGenerator_Gender(t *testing.T) {
+	cases := []struct {
+		description string
+		options     GeneratorOptions
+		min, max    int
+	}{
+		{description: "default", min: 400, max: 600},
+		{description: "all female", options: GeneratorOptions{FemaleShare: 1}, min: 1000, max: 1000},
+		{description: "mostly female", options: GeneratorOptions{FemaleShare: 0.7}, min: 600, max: 800},
+		{description: "only male", options: GeneratorOptions{Genders: []Gender{Male}}, min: 0, max: 0},
+		{description: "only female", options: GeneratorOptions{Genders: []Gender{Female}}, min: 1000, max: 1000},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			g := NewGeneratorWithOptions(rand.NewSource(1), tc.options)
+			female := 0
+
+			for i := 0; i < 1000; i++ {
+				person, err := g.AnyPerson()
+				require.NoError(t, err)
+
+				if person.Female() {
+					female++
+				}
+			}
+
+			assert.True(t, female >= tc.min && female <= tc.max, "got %d", female)
+		})
+	}
+}
+
+func TestGenerator_ZeroValue(t *testing.T) {
+	var g Generator
+
+	person, err := g.AnyPerson()
+	require.NoError(t, err)
+	assert.True(t, person.Valid())
+
+	org, err := g.AnyOrganization()
+	require.NoError(t, err)
+	assert.True(t, org.Valid())
+
+	person, err = NewGeneratorWithOptions(nil, GeneratorOptions{MinAge: 18}).AnyPerson()
+	require.NoError(t, err)
+	assert.True(t, person.IsOfAge(18))
+}
+
+func TestGenerator_Concurrent(t *testing.T) {
+	var (
+		g  = NewGeneratorWithSeed(1)
+		wg sync.WaitGroup
+	)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				_, _ = g.AnyPerson()
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestGenerator_InvalidDateRange(t *testing.T) {
+	g := NewGeneratorWithOptions(rand.NewSource(1), GeneratorOptions{MinAge: 50, MaxAge: 40})
+
+	_, err := g.AnyPerson()
+	assert.True(t, errors.Is(err, ErrInvalidDateRange))
+}
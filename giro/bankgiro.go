@@ -0,0 +1,128 @@
+package giro
+
+import (
+	"database/sql/driver"
+
+	"github.com/bombsimon/go-personnummer/internal/codec"
+)
+
+// Lengths of a bankgiro number.
+const (
+	BankgiroMinLength = 7
+	BankgiroMaxLength = 8
+)
+
+// nolint: gochecknoglobal
+var bankgiroFormat = format{min: BankgiroMinLength, max: BankgiroMaxLength, suffix: 4}
+
+// Bankgiro represents a bankgiro number (bankgironummer). The underlying value
+// is the digits without any dash.
+type Bankgiro string
+
+// ParseBankgiro parses a bankgiro number. Spaces and dashes are ignored. An
+// error is returned if the input isn't 7 or 8 digits, use Valid or Validate to
+// check the control digit.
+func ParseBankgiro(input string) (Bankgiro, error) {
+	number, err := bankgiroFormat.parse(input)
+
+	return Bankgiro(number), err
+}
+
+// IsValidBankgiro returns if the input is a valid bankgiro number.
+func IsValidBankgiro(input string) bool {
+	return bankgiroFormat.isValid(input)
+}
+
+// Validate returns an error if the bankgiro number isn't valid.
+func (b Bankgiro) Validate() error {
+	return bankgiroFormat.validate(string(b))
+}
+
+// Valid returns if the bankgiro number is valid.
+func (b Bankgiro) Valid() bool {
+	return b.Validate() == nil
+}
+
+// String returns the bankgiro number in the canonical form with a dash before
+// the last four digits, NNN-NNNN or NNNN-NNNN.
+func (b Bankgiro) String() string {
+	return bankgiroFormat.string(string(b))
+}
+
+// MarshalText implements encoding.TextMarshaler. The bankgiro number is
+// formatted in the canonical form.
+func (b Bankgiro) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. An error is returned if
+// the text isn't a valid bankgiro number.
+func (b *Bankgiro) UnmarshalText(text []byte) error {
+	number, err := bankgiroFormat.unmarshalText(text)
+	if err != nil {
+		return err
+	}
+
+	*b = Bankgiro(number)
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b Bankgiro) MarshalJSON() ([]byte, error) {
+	return marshalJSON(string(b), b)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both JSON strings and numbers are
+// accepted.
+func (b *Bankgiro) UnmarshalJSON(data []byte) error {
+	return codec.UnmarshalJSON(data, b)
+}
+
+// Scan implements sql.Scanner. Strings, bytes and integers are supported and
+// the value is validated. Scanning NULL returns ErrScanNull, use NullBankgiro
+// for nullable columns.
+func (b *Bankgiro) Scan(src interface{}) error {
+	number, err := bankgiroFormat.scan(src)
+	if err != nil {
+		return err
+	}
+
+	*b = Bankgiro(number)
+
+	return nil
+}
+
+// Value implements driver.Valuer. The bankgiro number is stored as digits
+// only, NNNNNNNN.
+func (b Bankgiro) Value() (driver.Value, error) {
+	return value(string(b))
+}
+
+// NullBankgiro represents a Bankgiro that may be NULL. It implements
+// sql.Scanner and driver.Valuer the same way as sql.NullString.
+type NullBankgiro struct {
+	Bankgiro Bankgiro
+	Valid    bool // Valid is true if Bankgiro is not NULL
+}
+
+// Scan implements sql.Scanner.
+func (n *NullBankgiro) Scan(src interface{}) error {
+	number, valid, err := bankgiroFormat.scanNull(src)
+	if err != nil {
+		return err
+	}
+
+	n.Bankgiro, n.Valid = Bankgiro(number), valid
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (n NullBankgiro) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Bankgiro.Value()
+}
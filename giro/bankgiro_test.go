@@ -0,0 +1,111 @@
+package giro
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBankgiro(t *testing.T) {
+	cases := []struct {
+		input  string
+		string string
+		err    error
+		valid  bool
+	}{
+		{input: "5050-1055", string: "5050-1055", valid: true},
+		{input: "50501055", string: "5050-1055", valid: true},
+		{input: " 5050 1055 ", string: "5050-1055", valid: true},
+		{input: "902-0900", string: "902-0900", valid: true},
+		{input: "5050-1056", string: "5050-1056", valid: false},
+		{input: "505-010", err: ErrInvalidLength},
+		{input: "5050-10555", err: ErrInvalidLength},
+		{input: "5050/1055", err: ErrInvalidFormat},
+		{input: "", err: ErrInvalidLength},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			b, err := ParseBankgiro(tc.input)
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+				assert.False(t, IsValidBankgiro(tc.input))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.string, b.String())
+			assert.Equal(t, tc.valid, b.Valid())
+			assert.Equal(t, tc.valid, IsValidBankgiro(tc.input))
+		})
+	}
+
+	assert.Equal(t, ErrInvalidChecksum, Bankgiro("50501056").Validate())
+	assert.Equal(t, ErrInvalidLength, Bankgiro("").Validate())
+}
+
+func TestBankgiro_JSON(t *testing.T) {
+	type payee struct {
+		Bankgiro Bankgiro `json:"bankgiro"`
+	}
+
+	got, err := json.Marshal(payee{Bankgiro: "50501055"})
+	require.NoError(t, err)
+	assert.Equal(t, `{"bankgiro":"5050-1055"}`, string(got))
+
+	got, err = json.Marshal(payee{})
+	require.NoError(t, err)
+	assert.Equal(t, `{"bankgiro":null}`, string(got))
+
+	for _, input := range []string{`"5050-1055"`, `"50501055"`, `50501055`} {
+		var p payee
+
+		require.NoError(t, json.Unmarshal([]byte(`{"bankgiro":`+input+`}`), &p))
+		assert.Equal(t, Bankgiro("50501055"), p.Bankgiro)
+	}
+
+	var p payee
+
+	assert.Equal(t, ErrInvalidChecksum, json.Unmarshal([]byte(`{"bankgiro":"5050-1056"}`), &p))
+	assert.NoError(t, json.Unmarshal([]byte(`{"bankgiro":null}`), &p))
+	assert.Equal(t, Bankgiro(""), p.Bankgiro)
+}
+
+func TestBankgiro_SQL(t *testing.T) {
+	var b Bankgiro
+
+	for _, src := range []interface{}{"5050-1055", []byte("50501055"), int64(50501055)} {
+		require.NoError(t, b.Scan(src))
+		assert.Equal(t, Bankgiro("50501055"), b)
+	}
+
+	assert.Equal(t, ErrScanNull, b.Scan(nil))
+	assert.Equal(t, ErrInvalidChecksum, b.Scan("5050-1056"))
+
+	value, err := b.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "50501055", value)
+
+	_, err = Bankgiro("").Value()
+	assert.Equal(t, ErrInvalidFormat, err)
+
+	var n NullBankgiro
+
+	require.NoError(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+
+	value, err = n.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	require.NoError(t, n.Scan("902-0900"))
+	assert.True(t, n.Valid)
+	assert.Equal(t, Bankgiro("9020900"), n.Bankgiro)
+
+	value, err = n.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "9020900", value)
+}
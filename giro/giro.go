@@ -0,0 +1,148 @@
+// Package giro parses and validates Swedish bankgiro and plusgiro numbers.
+//
+// Both numbers end with a control digit calculated with the Luhn algorithm over
+// all digits. A bankgiro number is 7 or 8 digits written as NNN-NNNN or
+// NNNN-NNNN and a plusgiro number is 2 to 8 digits written with the control
+// digit separated by a dash, e.g. NNNNNN-N.
+package giro
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"errors"
+	"strings"
+
+	"github.com/bombsimon/go-personnummer/internal/codec"
+	"github.com/bombsimon/go-personnummer/luhn"
+)
+
+// Errors returned when parsing or validating.
+// nolint: gochecknoglobal
+var (
+	ErrInvalidFormat   = errors.New("invalid format")
+	ErrInvalidLength   = errors.New("invalid length")
+	ErrInvalidChecksum = errors.New("invalid checksum")
+	ErrScanNull        = errors.New("cannot scan NULL value")
+)
+
+// format describes the length of a giro number and where the dash is written.
+// Bankgiro and Plusgiro only differ in these and use the methods on their
+// format for everything else.
+type format struct {
+	min, max int
+	// suffix is the number of digits written after the dash.
+	suffix int
+}
+
+// parse returns the digits in the input where spaces and dashes are ignored.
+// ErrInvalidFormat is returned if the input contains anything else and
+// ErrInvalidLength if the number of digits isn't between min and max.
+func (f format) parse(input string) (string, error) {
+	var sb strings.Builder
+
+	for _, c := range strings.TrimSpace(input) {
+		switch {
+		case c >= '0' && c <= '9':
+			sb.WriteRune(c)
+		case c == ' ' || c == '-':
+		default:
+			return "", ErrInvalidFormat
+		}
+	}
+
+	if sb.Len() < f.min || sb.Len() > f.max {
+		return "", ErrInvalidLength
+	}
+
+	return sb.String(), nil
+}
+
+// isValid returns if the input is a valid number.
+func (f format) isValid(input string) bool {
+	number, err := f.parse(input)
+	if err != nil {
+		return false
+	}
+
+	return f.validate(number) == nil
+}
+
+// validate returns an error if the number has the wrong length or the control
+// digit is wrong.
+func (f format) validate(number string) error {
+	if len(number) < f.min || len(number) > f.max {
+		return ErrInvalidLength
+	}
+
+	if !luhn.Valid(number) {
+		return ErrInvalidChecksum
+	}
+
+	return nil
+}
+
+// string returns the number with a dash before the suffix.
+func (f format) string(number string) string {
+	if len(number) <= f.suffix {
+		return number
+	}
+
+	return number[:len(number)-f.suffix] + "-" + number[len(number)-f.suffix:]
+}
+
+// unmarshalText parses and validates the text.
+func (f format) unmarshalText(text []byte) (string, error) {
+	number, err := f.parse(string(text))
+	if err != nil {
+		return "", err
+	}
+
+	if err := f.validate(number); err != nil {
+		return "", err
+	}
+
+	return number, nil
+}
+
+// marshalJSON marshals the number as a JSON string or null if empty.
+func marshalJSON(number string, m encoding.TextMarshaler) ([]byte, error) {
+	if number == "" {
+		return []byte("null"), nil
+	}
+
+	return codec.MarshalJSON(m)
+}
+
+// scan parses and validates a value scanned from a database. Scanning NULL
+// returns ErrScanNull.
+func (f format) scan(src interface{}) (string, error) {
+	if src == nil {
+		return "", ErrScanNull
+	}
+
+	return f.unmarshalText([]byte(codec.StringFromInterface(src)))
+}
+
+// scanNull works like scan but returns false instead of an error when scanning
+// NULL.
+func (f format) scanNull(src interface{}) (string, bool, error) {
+	if src == nil {
+		return "", false, nil
+	}
+
+	number, err := f.scan(src)
+	if err != nil {
+		return "", false, err
+	}
+
+	return number, true, nil
+}
+
+// value returns the number as stored in a database, digits only.
+func value(number string) (driver.Value, error) {
+	if number == "" {
+		return nil, ErrInvalidFormat
+	}
+
+	return number, nil
+}
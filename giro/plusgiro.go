@@ -0,0 +1,128 @@
+package giro
+
+import (
+	"database/sql/driver"
+
+	"github.com/bombsimon/go-personnummer/internal/codec"
+)
+
+// Lengths of a plusgiro number.
+const (
+	PlusgiroMinLength = 2
+	PlusgiroMaxLength = 8
+)
+
+// nolint: gochecknoglobal
+var plusgiroFormat = format{min: PlusgiroMinLength, max: PlusgiroMaxLength, suffix: 1}
+
+// Plusgiro represents a plusgiro number (plusgironummer). The underlying value
+// is the digits without any dash.
+type Plusgiro string
+
+// ParsePlusgiro parses a plusgiro number. Spaces and dashes are ignored. An
+// error is returned if the input isn't 2 to 8 digits, use Valid or Validate to
+// check the control digit.
+func ParsePlusgiro(input string) (Plusgiro, error) {
+	number, err := plusgiroFormat.parse(input)
+
+	return Plusgiro(number), err
+}
+
+// IsValidPlusgiro returns if the input is a valid plusgiro number.
+func IsValidPlusgiro(input string) bool {
+	return plusgiroFormat.isValid(input)
+}
+
+// Validate returns an error if the plusgiro number isn't valid.
+func (p Plusgiro) Validate() error {
+	return plusgiroFormat.validate(string(p))
+}
+
+// Valid returns if the plusgiro number is valid.
+func (p Plusgiro) Valid() bool {
+	return p.Validate() == nil
+}
+
+// String returns the plusgiro number in the canonical form with a dash before
+// the control digit, e.g. NNNNNN-N.
+func (p Plusgiro) String() string {
+	return plusgiroFormat.string(string(p))
+}
+
+// MarshalText implements encoding.TextMarshaler. The plusgiro number is
+// formatted in the canonical form.
+func (p Plusgiro) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. An error is returned if
+// the text isn't a valid plusgiro number.
+func (p *Plusgiro) UnmarshalText(text []byte) error {
+	number, err := plusgiroFormat.unmarshalText(text)
+	if err != nil {
+		return err
+	}
+
+	*p = Plusgiro(number)
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p Plusgiro) MarshalJSON() ([]byte, error) {
+	return marshalJSON(string(p), p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both JSON strings and numbers are
+// accepted.
+func (p *Plusgiro) UnmarshalJSON(data []byte) error {
+	return codec.UnmarshalJSON(data, p)
+}
+
+// Scan implements sql.Scanner. Strings, bytes and integers are supported and
+// the value is validated. Scanning NULL returns ErrScanNull, use NullPlusgiro
+// for nullable columns.
+func (p *Plusgiro) Scan(src interface{}) error {
+	number, err := plusgiroFormat.scan(src)
+	if err != nil {
+		return err
+	}
+
+	*p = Plusgiro(number)
+
+	return nil
+}
+
+// Value implements driver.Valuer. The plusgiro number is stored as digits
+// only, e.g. NNNNNNNN.
+func (p Plusgiro) Value() (driver.Value, error) {
+	return value(string(p))
+}
+
+// NullPlusgiro represents a Plusgiro that may be NULL. It implements
+// sql.Scanner and driver.Valuer the same way as sql.NullString.
+type NullPlusgiro struct {
+	Plusgiro Plusgiro
+	Valid    bool // Valid is true if Plusgiro is not NULL
+}
+
+// Scan implements sql.Scanner.
+func (n *NullPlusgiro) Scan(src interface{}) error {
+	number, valid, err := plusgiroFormat.scanNull(src)
+	if err != nil {
+		return err
+	}
+
+	n.Plusgiro, n.Valid = Plusgiro(number), valid
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (n NullPlusgiro) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Plusgiro.Value()
+}
@@ -0,0 +1,97 @@
+package giro
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlusgiro(t *testing.T) {
+	cases := []struct {
+		input  string
+		string string
+		err    error
+		valid  bool
+	}{
+		{input: "900003-5", string: "900003-5", valid: true},
+		{input: "9000035", string: "900003-5", valid: true},
+		{input: "90 00 03-5", string: "900003-5", valid: true},
+		{input: "1-8", string: "1-8", valid: true},
+		{input: "5050105-5", string: "5050105-5", valid: true},
+		{input: "900003-6", string: "900003-6", valid: false},
+		{input: "8", err: ErrInvalidLength},
+		{input: "12345678-9", err: ErrInvalidLength},
+		{input: "PG 900003-5", err: ErrInvalidFormat},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			p, err := ParsePlusgiro(tc.input)
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+				assert.False(t, IsValidPlusgiro(tc.input))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.string, p.String())
+			assert.Equal(t, tc.valid, p.Valid())
+			assert.Equal(t, tc.valid, IsValidPlusgiro(tc.input))
+		})
+	}
+
+	assert.Equal(t, ErrInvalidChecksum, Plusgiro("9000036").Validate())
+	assert.Equal(t, ErrInvalidLength, Plusgiro("").Validate())
+}
+
+func TestPlusgiro_JSON(t *testing.T) {
+	type payee struct {
+		Plusgiro Plusgiro `json:"plusgiro"`
+	}
+
+	got, err := json.Marshal(payee{Plusgiro: "9000035"})
+	require.NoError(t, err)
+	assert.Equal(t, `{"plusgiro":"900003-5"}`, string(got))
+
+	got, err = json.Marshal(payee{})
+	require.NoError(t, err)
+	assert.Equal(t, `{"plusgiro":null}`, string(got))
+
+	for _, input := range []string{`"900003-5"`, `"9000035"`, `9000035`} {
+		var p payee
+
+		require.NoError(t, json.Unmarshal([]byte(`{"plusgiro":`+input+`}`), &p))
+		assert.Equal(t, Plusgiro("9000035"), p.Plusgiro)
+	}
+
+	var p payee
+
+	assert.Equal(t, ErrInvalidChecksum, json.Unmarshal([]byte(`{"plusgiro":"900003-6"}`), &p))
+}
+
+func TestPlusgiro_SQL(t *testing.T) {
+	var p Plusgiro
+
+	for _, src := range []interface{}{"900003-5", []byte("9000035"), int64(9000035)} {
+		require.NoError(t, p.Scan(src))
+		assert.Equal(t, Plusgiro("9000035"), p)
+	}
+
+	assert.Equal(t, ErrScanNull, p.Scan(nil))
+
+	value, err := p.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "9000035", value)
+
+	var n NullPlusgiro
+
+	require.NoError(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+
+	require.NoError(t, n.Scan("1-8"))
+	assert.True(t, n.Valid)
+	assert.Equal(t, Plusgiro("18"), n.Plusgiro)
+}
@@ -0,0 +1,102 @@
+package personnummer
+
+// Identity is the result of identifying an input. Kind tells what kind of
+// number the input is and the matching type is set; Person for KindPerson,
+// KindCoordination and KindSoleTrader, Organization for KindOrganization and
+// Interim for KindInterim.
+type Identity struct {
+	Kind         Kind
+	Parsed       *Parsed
+	Person       *Person
+	Organization *Organization
+	Interim      *InterimNumber
+}
+
+// Identify parses the input and classifies it as a personal identity number,
+// coordination number, organization number, sole trader or interim number.
+// The Kind on the returned Parsed type is set to the identified kind.
+//
+// A sole trader (enskild firma) uses the personal identity number of the owner
+// as organization number. Since the numbers are the same an input is only
+// identified as a sole trader if it's a valid personal identity number written
+// in the long organization form prefixed with 16, e.g. 16800101-3294.
+//
+// If the input isn't valid as any kind an error is returned. The error is a
+// ValidationError from validating the input as an organization if the third
+// digit is 2 or higher and as a person otherwise.
+func Identify(input string) (Identity, error) {
+	return IdentifyWithOptions(input, Options{})
+}
+
+// IdentifyWithOptions works like Identify but uses the passed options when
+// parsing the input and creating people.
+func IdentifyWithOptions(input string, options Options) (Identity, error) {
+	parsed, err := ParseWithOptions(input, options)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	if parsed.Kind == KindInterim {
+		in, err := NewInterimNumberFromParsedWithOptions(parsed, options)
+		if err == nil {
+			err = in.Validate()
+		}
+
+		if err != nil {
+			return Identity{}, err
+		}
+
+		return Identity{
+			Kind:    KindInterim,
+			Parsed:  in.Parsed,
+			Person:  in.Person,
+			Interim: in,
+		}, nil
+	}
+
+	org, _ := NewOrganizationFromParsed(parsed)
+
+	orgErr := org.Validate()
+	if orgErr == nil {
+		parsed.Kind = KindOrganization
+
+		return Identity{
+			Kind:         KindOrganization,
+			Parsed:       parsed,
+			Organization: org,
+		}, nil
+	}
+
+	personParsed := *parsed
+	kind := KindPerson
+
+	if personParsed.Century == 1600 {
+		personParsed.Century = 0
+		kind = KindSoleTrader
+	}
+
+	person, err := NewPersonFromParsedWithOptions(&personParsed, options)
+	if err == nil {
+		err = person.Validate()
+	}
+
+	if err != nil {
+		if parsed.Month >= 20 {
+			return Identity{}, orgErr
+		}
+
+		return Identity{}, err
+	}
+
+	if kind == KindPerson && person.IsCoordination {
+		kind = KindCoordination
+	}
+
+	person.Kind = kind
+
+	return Identity{
+		Kind:   kind,
+		Parsed: person.Parsed,
+		Person: person,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package personnummer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentify(t *testing.T) {
+	cases := []struct {
+		input   string
+		kind    Kind
+		wantErr error
+	}{
+		{input: "😸", wantErr: ErrInvalidFormat},
+		{input: "800101-3294", kind: KindPerson},
+		{input: "19800101-3294", kind: KindPerson},
+		{input: "180377-2381", kind: KindCoordination},
+		{input: "556703-7485", kind: KindOrganization},
+		{input: "16556703-7485", kind: KindOrganization},
+		{input: "16800101-3294", kind: KindSoleTrader},
+		{input: "19800101-T124", kind: KindInterim},
+		{input: "19800101-K124", kind: KindInterim},
+		{input: "99800101-3294", kind: KindInterim},
+		{input: "800101-A124", wantErr: ErrInvalidSerial},
+		{input: "19800101-T125", wantErr: ErrInvalidChecksum},
+		{input: "800101-3295", wantErr: ErrInvalidChecksum},
+		{input: "801301-3294", wantErr: ErrInvalidDate},
+		{input: "556703+7485", wantErr: ErrInvalidDivider},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			identity, err := Identify(tc.input)
+
+			if tc.wantErr != nil {
+				assert.True(t, errors.Is(err, tc.wantErr), "got %v", err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.kind, identity.Kind)
+			assert.Equal(t, tc.kind, identity.Parsed.Kind)
+
+			switch tc.kind {
+			case KindOrganization:
+				require.NotNil(t, identity.Organization)
+				assert.Nil(t, identity.Person)
+			case KindInterim:
+				require.NotNil(t, identity.Interim)
+				require.NotNil(t, identity.Person)
+			default:
+				require.NotNil(t, identity.Person)
+				assert.Nil(t, identity.Organization)
+			}
+		})
+	}
+}
+
+func TestKind_String(t *testing.T) {
+	assert.Equal(t, "Coordination number", KindCoordination.String())
+	assert.Equal(t, "Unknown", Kind(-1).String())
+}
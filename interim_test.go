@@ -0,0 +1,218 @@
+package personnummer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInterimNumber(t *testing.T) {
+	cases := []struct {
+		description string
+		input       string
+		letter      rune
+		region      Region
+		date        time.Time
+		gender      Gender
+		output      string
+		wantErr     bool
+	}{
+		{
+			description: "invalid input",
+			input:       "😸",
+			wantErr:     true,
+		},
+		{
+			description: "regular personal identity number",
+			input:       "800101-3294",
+			wantErr:     true,
+		},
+		{
+			description: "letter not used for interim numbers",
+			input:       "800101-A124",
+			wantErr:     true,
+		},
+		{
+			description: "invalid date",
+			input:       "19801301-T124",
+			wantErr:     true,
+		},
+		{
+			description: "with century",
+			input:       "19800101-T124",
+			letter:      'T',
+			date:        time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC),
+			gender:      Female,
+			output:      "800101-T124",
+		},
+		{
+			description: "lower case letter without divider",
+			input:       "900614r981",
+			letter:      'R',
+			date:        time.Date(1990, 6, 14, 0, 0, 0, 0, time.UTC),
+			gender:      Female,
+			output:      "900614-R981",
+		},
+		{
+			description: "this century",
+			input:       "20020202-S457",
+			letter:      'S',
+			date:        time.Date(2002, 2, 2, 0, 0, 0, 0, time.UTC),
+			gender:      Male,
+			output:      "020202-S457",
+		},
+		{
+			description: "västra götaland",
+			input:       "19800101-K124",
+			letter:      'K',
+			region:      RegionVastraGotaland,
+			date:        time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC),
+			gender:      Female,
+			output:      "800101-K124",
+		},
+		{
+			description: "stockholm",
+			input:       "99800101-3294",
+			region:      RegionStockholm,
+			date:        time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC),
+			gender:      Male,
+			output:      "99800101-3294",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			in, err := NewInterimNumber(tc.input)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, in)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, KindInterim, in.Kind)
+			assert.Equal(t, tc.letter, in.Letter)
+			assert.Equal(t, tc.region, in.Region)
+			assert.Equal(t, tc.date, in.Date)
+			assert.Equal(t, tc.gender, in.Gender)
+			assert.Equal(t, CountyUnknown, in.County)
+			assert.Equal(t, tc.output, in.String())
+			assert.True(t, in.Valid())
+		})
+	}
+}
+
+func TestIsValidInterimNumber(t *testing.T) {
+	cases := []struct {
+		input string
+		valid bool
+	}{
+		{input: "800101-T124", valid: true},
+		{input: "19800101T124", valid: true},
+		{input: "800101-X124", valid: true},
+		{input: "800101-T125", valid: false},
+		{input: "800101-3294", valid: false},
+		{input: "800101-Q124", valid: false},
+		{input: "800101-M124", valid: true},
+		{input: "99800101-3294", valid: true},
+		{input: "99800101-3295", valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%s is %v", tc.input, tc.valid), func(t *testing.T) {
+			assert.Equal(t, tc.valid, IsValidInterimNumber(tc.input))
+		})
+	}
+}
+
+func TestParse_Interim(t *testing.T) {
+	cases := []struct {
+		input  string
+		letter rune
+		serial int
+		kind   Kind
+		err    error
+		offset int
+	}{
+		{input: "19800101-T124", letter: 'T', serial: 112, kind: KindInterim},
+		{input: "800101t12", letter: 'T', serial: 112, kind: KindInterim},
+		{input: "800101-K124", letter: 'K', serial: 112, kind: KindInterim},
+		{input: "99800101-3294", serial: 329, kind: KindInterim},
+		{input: "800101-A124", err: ErrInvalidSerial, offset: 7},
+		{input: "19800101A124", err: ErrInvalidSerial, offset: 8},
+		{input: "800101-1T24", err: ErrInvalidFormat},
+		{input: "800101-TT24", err: ErrInvalidFormat},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			parsed, err := Parse(tc.input)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "got %v", err)
+
+				var vErr *ValidationError
+				if errors.As(err, &vErr) {
+					assert.Equal(t, PositionSerial, vErr.Position)
+					assert.Equal(t, tc.offset, vErr.Offset)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.letter, parsed.Letter)
+			assert.Equal(t, tc.serial, parsed.Serial)
+			assert.Equal(t, tc.kind, parsed.Kind)
+			assert.True(t, parsed.Valid())
+		})
+	}
+}
+
+func TestParse_Kind(t *testing.T) {
+	cases := []struct {
+		input string
+		kind  Kind
+	}{
+		{input: "800101-3294", kind: KindPerson},
+		{input: "800161-3299", kind: KindCoordination},
+		{input: "556703-7485", kind: KindOrganization},
+		{input: "16556703-7485", kind: KindOrganization},
+		{input: "16800101-3294", kind: KindSoleTrader},
+		{input: "800101-T124", kind: KindInterim},
+		{input: "99800101-3294", kind: KindInterim},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			parsed, err := Parse(tc.input)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.kind, parsed.Kind)
+		})
+	}
+}
+
+func TestNewPerson_Interim(t *testing.T) {
+	for _, input := range []string{"800101-T124", "99800101-3294"} {
+		_, err := NewPerson(input)
+		assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+		assert.False(t, IsValidPerson(input))
+
+		_, err = NewOrganization(input)
+		assert.True(t, errors.Is(err, ErrInvalidFormat), "got %v", err)
+	}
+}
+
+func TestRegion_String(t *testing.T) {
+	assert.Equal(t, "Region Stockholm", RegionStockholm.String())
+	assert.Equal(t, "Västra Götalandsregionen", RegionVastraGotaland.String())
+	assert.Equal(t, "Unknown", Region(-1).String())
+}
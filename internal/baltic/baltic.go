@@ -0,0 +1,139 @@
+// Package baltic implements the personal code format shared by Estonia and
+// Lithuania, GYYMMDDSSSC, where G tells the gender and century, YYMMDD is the
+// date of birth, SSS is a serial number and C is a control digit.
+package baltic
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+// Length is the number of digits in a personal code.
+const Length = 11
+
+// Weights used to calculate the control digit, the second ones are only used
+// if the first ones give a remainder of 10.
+// nolint: gochecknoglobal
+var (
+	firstWeights  = [...]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 1}
+	secondWeights = [...]int{3, 4, 5, 6, 7, 8, 9, 1, 2, 3}
+)
+
+// Code is a decoded personal code.
+type Code struct {
+	// Number is the eleven digits of the code.
+	Number string
+	Date   time.Time
+	Gender personnummer.Gender
+	Serial int
+}
+
+// Decode decodes the personal code. An error is returned if the input isn't
+// eleven digits, the first digit isn't 1-8 or the date isn't valid.
+func Decode(input string) (Code, error) {
+	nr := strings.TrimSpace(input)
+	if len(nr) != Length {
+		return Code{}, personnummer.ErrInvalidFormat
+	}
+
+	for i := 0; i < len(nr); i++ {
+		if nr[i] < '0' || nr[i] > '9' {
+			return Code{}, personnummer.ErrInvalidFormat
+		}
+	}
+
+	first := int(nr[0] - '0')
+	if first < 1 || first > 8 {
+		return Code{}, personnummer.ErrInvalidCentury
+	}
+
+	var (
+		year, _   = strconv.Atoi(nr[1:3])
+		month, _  = strconv.Atoi(nr[3:5])
+		day, _    = strconv.Atoi(nr[5:7])
+		serial, _ = strconv.Atoi(nr[7:10])
+	)
+
+	year += Century(first)
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day || int(date.Month()) != month {
+		return Code{}, personnummer.ErrInvalidDate
+	}
+
+	return Code{
+		Number: nr,
+		Date:   date,
+		Gender: Gender(first),
+		Serial: serial,
+	}, nil
+}
+
+// Validate returns personnummer.ErrInvalidChecksum if the control digit is
+// wrong.
+func (c Code) Validate() error {
+	if len(c.Number) != Length {
+		return personnummer.ErrInvalidFormat
+	}
+
+	if int(c.Number[10]-'0') != ControlDigit(c.Number[:10]) {
+		return personnummer.ErrInvalidChecksum
+	}
+
+	return nil
+}
+
+// Century returns the century for the first digit, 1-2 for 1800, 3-4 for 1900,
+// 5-6 for 2000 and 7-8 for 2100.
+func Century(first int) int {
+	return 1800 + (first-1)/2*100
+}
+
+// Gender returns the gender for the first digit, odd for males and even for
+// females.
+func Gender(first int) personnummer.Gender {
+	if first%2 == 1 {
+		return personnummer.Male
+	}
+
+	return personnummer.Female
+}
+
+// First returns the first digit for the century and gender.
+func First(century int, gender personnummer.Gender) int {
+	first := (century-1800)/100*2 + 1
+	if gender == personnummer.Female {
+		first++
+	}
+
+	return first
+}
+
+// ControlDigit returns the control digit for the first ten digits. The digits
+// are weighted with 1-9,1 and the sum modulo 11 is the control digit. If it's
+// 10 the digits are weighted with 3-9,1-3 instead and if that also gives 10
+// the control digit is 0.
+func ControlDigit(digits string) int {
+	if r := weightedSum(digits, firstWeights[:]) % 11; r != 10 {
+		return r
+	}
+
+	if r := weightedSum(digits, secondWeights[:]) % 11; r != 10 {
+		return r
+	}
+
+	return 0
+}
+
+// weightedSum returns the sum of the digits multiplied by the weights.
+func weightedSum(digits string, weights []int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += int(digits[i]-'0') * w
+	}
+
+	return sum
+}
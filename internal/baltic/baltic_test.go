@@ -0,0 +1,50 @@
+package baltic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+func TestControlDigit(t *testing.T) {
+	cases := []struct {
+		description  string
+		digits       string
+		controlDigit int
+	}{
+		{description: "first stage", digits: "3760503029", controlDigit: 9},
+		{description: "second stage", digits: "3800101001", controlDigit: 5},
+		{description: "second stage gives 10", digits: "3800101025", controlDigit: 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.controlDigit, ControlDigit(tc.digits))
+		})
+	}
+}
+
+func TestCenturyAndGender(t *testing.T) {
+	cases := []struct {
+		first   int
+		century int
+		gender  personnummer.Gender
+	}{
+		{first: 1, century: 1800, gender: personnummer.Male},
+		{first: 2, century: 1800, gender: personnummer.Female},
+		{first: 3, century: 1900, gender: personnummer.Male},
+		{first: 4, century: 1900, gender: personnummer.Female},
+		{first: 5, century: 2000, gender: personnummer.Male},
+		{first: 6, century: 2000, gender: personnummer.Female},
+		{first: 7, century: 2100, gender: personnummer.Male},
+		{first: 8, century: 2100, gender: personnummer.Female},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.century, Century(tc.first))
+		assert.Equal(t, tc.gender, Gender(tc.first))
+		assert.Equal(t, tc.first, First(tc.century, tc.gender))
+	}
+}
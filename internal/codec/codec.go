@@ -0,0 +1,74 @@
+// Package codec holds the encoding helpers shared by the number types in this
+// module.
+package codec
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"strconv"
+)
+
+// StringFromInterface returns the string value from an interface, such as a
+// value scanned from a database. Strings, bytes and numbers are supported and
+// an empty string is returned for any other type.
+func StringFromInterface(input interface{}) string {
+	var nr string
+
+	switch v := input.(type) {
+	case string:
+		nr = v
+	case []byte:
+		nr = string(v)
+	case int:
+		nr = strconv.Itoa(v)
+	case int32:
+		nr = strconv.Itoa(int(v))
+	case int64:
+		nr = strconv.Itoa(int(v))
+	case float32:
+		nr = strconv.Itoa(int(v))
+	case float64:
+		nr = strconv.Itoa(int(v))
+	default:
+		nr = ""
+	}
+
+	return nr
+}
+
+// MarshalJSON marshals the text representation as a JSON string.
+func MarshalJSON(m encoding.TextMarshaler) ([]byte, error) {
+	text, err := m.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals a JSON string or number with the text unmarshaler.
+// A JSON null is a no-op.
+func UnmarshalJSON(data []byte, u encoding.TextUnmarshaler) error {
+	data = bytes.TrimSpace(data)
+
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] != '"' {
+		var nr json.Number
+		if err := json.Unmarshal(data, &nr); err != nil {
+			return err
+		}
+
+		return u.UnmarshalText([]byte(nr))
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	return u.UnmarshalText([]byte(s))
+}
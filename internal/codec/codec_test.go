@@ -0,0 +1,80 @@
+package codec
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type text string
+
+func (t text) MarshalText() ([]byte, error) {
+	if t == "" {
+		return nil, errors.New("empty")
+	}
+
+	return []byte(t), nil
+}
+
+func (t *text) UnmarshalText(b []byte) error {
+	*t = text(b)
+
+	return nil
+}
+
+func TestStringFromInterface(t *testing.T) {
+	cases := []struct {
+		input  interface{}
+		output string
+	}{
+		{input: "12", output: "12"},
+		{input: []byte("12"), output: "12"},
+		{input: int64(12), output: "12"},
+		{input: float64(12), output: "12"},
+		{input: nil, output: ""},
+		{input: struct{}{}, output: ""},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.output, StringFromInterface(tc.input))
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	b, err := MarshalJSON(text("800101-3294"))
+	require.NoError(t, err)
+	assert.Equal(t, `"800101-3294"`, string(b))
+
+	_, err = MarshalJSON(text(""))
+	assert.Error(t, err)
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		input  string
+		output text
+		err    bool
+	}{
+		{input: `"800101-3294"`, output: "800101-3294"},
+		{input: ` 8001013294 `, output: "8001013294"},
+		{input: `null`, output: "unchanged"},
+		{input: `{}`, output: "unchanged", err: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			v := text("unchanged")
+
+			err := UnmarshalJSON([]byte(tc.input), &v)
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.output, v)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Package national holds the parts shared by the packages for identity numbers
+// from other countries; the options and age calculation for a date of birth and
+// the random source used by generators.
+package national
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+// Options holds settings used when creating a person. The zero value is ready
+// to use.
+type Options struct {
+	// Now returns the reference time used when calculating age. Defaults to
+	// time.Now.
+	Now func() time.Time
+
+	// Location is the location used to tell the date when calculating age.
+	// Defaults to the location of the country the number is from, or UTC if
+	// the time zone database isn't available.
+	Location *time.Location
+}
+
+// Location is a named location which is loaded the first time it's used.
+type Location struct {
+	name string
+	once sync.Once
+	loc  *time.Location
+}
+
+// NewLocation returns a Location for the name in the time zone database, e.g.
+// Europe/Oslo.
+func NewLocation(name string) *Location {
+	return &Location{name: name}
+}
+
+// Load returns the location or UTC if the time zone database isn't available,
+// see personnummer.DefaultLocation.
+func (l *Location) Load() *time.Location {
+	l.once.Do(func() {
+		loc, err := time.LoadLocation(l.name)
+		if err != nil {
+			loc = time.UTC
+		}
+
+		l.loc = loc
+	})
+
+	return l.loc
+}
+
+// Birth holds a date of birth and the options used to calculate age from it.
+// It's embedded in the person types to give them the age methods. The zero
+// value has no date of birth and always gives the age 0.
+type Birth struct {
+	date            time.Time
+	options         Options
+	defaultLocation *Location
+}
+
+// NewBirth returns a Birth for the date of birth. The date is taken in
+// defaultLocation when calculating age unless a location is set in the
+// options.
+func NewBirth(date time.Time, options Options, defaultLocation *Location) Birth {
+	return Birth{
+		date:            date,
+		options:         options,
+		defaultLocation: defaultLocation,
+	}
+}
+
+// Age returns the age of the person based on today's date, or the reference
+// time from the options the person was created with.
+func (b Birth) Age() int {
+	return b.AgeAt(b.now())
+}
+
+// AgeAt returns the age of the person at the passed time.
+func (b Birth) AgeAt(t time.Time) int {
+	return b.AgeDetailAt(t).Years
+}
+
+// AgeDetail returns the age of the person in years, months and days based on
+// today's date, or the reference time from the options the person was created
+// with.
+func (b Birth) AgeDetail() personnummer.AgeDetail {
+	return b.AgeDetailAt(b.now())
+}
+
+// AgeDetailAt returns the age of the person in years, months and days at the
+// passed time in the location from the options, see
+// personnummer.CalculateAge.
+func (b Birth) AgeDetailAt(t time.Time) personnummer.AgeDetail {
+	if b.date.IsZero() {
+		return personnummer.AgeDetail{}
+	}
+
+	return personnummer.CalculateAge(b.date, t, b.location())
+}
+
+// IsOfAge returns if the person is at least age years old.
+func (b Birth) IsOfAge(age int) bool {
+	return b.Age() >= age
+}
+
+// IsOfAgeAt returns if the person is at least age years old at the passed
+// time.
+func (b Birth) IsOfAgeAt(t time.Time, age int) bool {
+	return b.AgeAt(t) >= age
+}
+
+// now returns the reference time from the options.
+func (b Birth) now() time.Time {
+	if b.options.Now == nil {
+		return time.Now()
+	}
+
+	return b.options.Now()
+}
+
+// location returns the location from the options or the default location.
+func (b Birth) location() *time.Location {
+	if b.options.Location != nil {
+		return b.options.Location
+	}
+
+	if b.defaultLocation == nil {
+		return nil
+	}
+
+	return b.defaultLocation.Load()
+}
+
+// Rand is a source of random numbers which is safe for concurrent use. The
+// zero value is ready to use.
+type Rand struct {
+	// Source is the source of the random numbers. If nil a source seeded with
+	// the current time is created on first use.
+	Source rand.Source
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// Intn returns a random number in [0,n).
+func (r *Rand) Intn(n int) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.rand == nil {
+		src := r.Source
+		if src == nil {
+			src = rand.NewSource(time.Now().UnixNano())
+		}
+
+		r.rand = rand.New(src) // nolint: gosec
+	}
+
+	return r.rand.Intn(n)
+}
@@ -0,0 +1,76 @@
+package national
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+func TestBirth(t *testing.T) {
+	var (
+		date = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+		now  = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	b := NewBirth(date, Options{Now: func() time.Time { return now }}, NewLocation("UTC"))
+
+	assert.Equal(t, 40, b.Age())
+	assert.Equal(t, 39, b.AgeAt(now.AddDate(0, 0, -1)))
+	assert.Equal(t, personnummer.AgeDetail{Years: 40}, b.AgeDetail())
+	assert.Equal(t, personnummer.AgeDetail{Years: 39, Months: 11, Days: 30, DaysUntilNextBirthday: 1}, b.AgeDetailAt(now.AddDate(0, 0, -1)))
+	assert.True(t, b.IsOfAge(40))
+	assert.False(t, b.IsOfAgeAt(now.AddDate(0, 0, -1), 40))
+
+	assert.Equal(t, 0, Birth{}.Age())
+	assert.Equal(t, personnummer.AgeDetail{}, Birth{}.AgeDetailAt(now))
+}
+
+func TestBirth_Location(t *testing.T) {
+	var (
+		date = time.Date(1980, 1, 2, 0, 0, 0, 0, time.UTC)
+		// 23:30 UTC is the day after in Europe/Oslo.
+		now = time.Date(2020, 1, 1, 23, 30, 0, 0, time.UTC)
+		utc = NewLocation("UTC")
+	)
+
+	assert.Equal(t, 39, NewBirth(date, Options{}, utc).AgeAt(now))
+	assert.Equal(t, 40, NewBirth(date, Options{}, NewLocation("Europe/Oslo")).AgeAt(now))
+	assert.Equal(t, 40, NewBirth(date, Options{Location: time.FixedZone("", 3600)}, utc).AgeAt(now))
+}
+
+func TestLocation(t *testing.T) {
+	assert.Equal(t, "Europe/Oslo", NewLocation("Europe/Oslo").Load().String())
+	assert.Equal(t, time.UTC, NewLocation("Nowhere/Nowhere").Load())
+}
+
+func TestRand(t *testing.T) {
+	a := &Rand{Source: rand.NewSource(1)}
+	b := &Rand{Source: rand.NewSource(1)}
+
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, a.Intn(1000), b.Intn(1000))
+	}
+
+	var (
+		zero Rand
+		wg   sync.WaitGroup
+	)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			n := zero.Intn(10)
+			assert.True(t, n >= 0 && n < 10)
+		}()
+	}
+
+	wg.Wait()
+}
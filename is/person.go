@@ -0,0 +1,173 @@
+// Package is parses and validates Icelandic identification numbers
+// (kennitala).
+//
+// A kennitala is ten digits, DDMMYY-RRKC, where DDMMYY is the date of birth,
+// RR are random digits, K is a control digit calculated with mod 11 and C
+// tells the century, 8 for 1800, 9 for 1900 and 0 for 2000. People and
+// companies share the same number space where companies have 40 added to the
+// day and the date is the date of registration. The kennitala doesn't tell the
+// gender.
+package is
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+	"github.com/bombsimon/go-personnummer/internal/national"
+)
+
+const (
+	// numberLength is the number of digits in a kennitala.
+	numberLength = 10
+
+	// companyOffset is added to the day for companies.
+	companyOffset = 40
+)
+
+// weights are used to calculate the control digit.
+// nolint: gochecknoglobal
+var weights = [...]int{3, 2, 7, 6, 5, 4, 3, 2}
+
+// defaultLocation is the location used when calculating age if not set in the
+// options.
+// nolint: gochecknoglobal
+var defaultLocation = national.NewLocation("Atlantic/Reykjavik")
+
+// Options holds settings used when creating a Person. The zero value is ready
+// to use and calculates age in Atlantic/Reykjavik.
+type Options = national.Options
+
+// birth gives Person the age methods, see national.Birth.
+type birth = national.Birth
+
+// Person represents a parsed kennitala, either of a person or a company. It
+// has the same age methods as personnummer.Person, such as Age and IsOfAge,
+// where the age of a company is counted from the date of registration.
+type Person struct {
+	// Number is the ten digits of the kennitala without dash.
+	Number string
+
+	// Date is the date of birth, or the date of registration for a company.
+	Date time.Time
+
+	// IsCompany is true if the kennitala belongs to a company.
+	IsCompany bool
+
+	birth
+}
+
+// NewPerson parses and returns a pointer to a Person based on the input. The
+// input is ten digits, optionally with a dash or space after the sixth digit.
+// An error is returned if the input isn't in the right format or the date isn't
+// valid, use Valid or Validate to check the control digit.
+func NewPerson(input string) (*Person, error) {
+	return NewPersonWithOptions(input, Options{})
+}
+
+// NewPersonWithOptions works like NewPerson but uses the passed options.
+func NewPersonWithOptions(input string, options Options) (*Person, error) {
+	nr := strings.TrimSpace(input)
+	if len(nr) == numberLength+1 && (nr[6] == '-' || nr[6] == ' ') {
+		nr = nr[:6] + nr[7:]
+	}
+
+	if len(nr) != numberLength {
+		return nil, personnummer.ErrInvalidFormat
+	}
+
+	for i := 0; i < len(nr); i++ {
+		if nr[i] < '0' || nr[i] > '9' {
+			return nil, personnummer.ErrInvalidFormat
+		}
+	}
+
+	century, ok := centuryFromDigit(nr[9])
+	if !ok {
+		return nil, personnummer.ErrInvalidCentury
+	}
+
+	var (
+		day, _   = strconv.Atoi(nr[0:2])
+		month, _ = strconv.Atoi(nr[2:4])
+		year, _  = strconv.Atoi(nr[4:6])
+	)
+
+	isCompany := day > companyOffset
+	if isCompany {
+		day -= companyOffset
+	}
+
+	date := time.Date(century+year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day || int(date.Month()) != month {
+		return nil, personnummer.ErrInvalidDate
+	}
+
+	return &Person{
+		Number:    nr,
+		Date:      date,
+		IsCompany: isCompany,
+		birth:     national.NewBirth(date, options, defaultLocation),
+	}, nil
+}
+
+// IsValidPerson returns if the input is a valid kennitala of a person or a
+// company.
+func IsValidPerson(input string) bool {
+	p, err := NewPerson(input)
+	if err != nil {
+		return false
+	}
+
+	return p.Valid()
+}
+
+// Validate returns personnummer.ErrInvalidChecksum if the control digit is
+// wrong. The control digit is 11 minus the weighted sum of the first eight
+// digits modulo 11 where 11 is 0, a control digit of 10 is never valid.
+func (p *Person) Validate() error {
+	if len(p.Number) != numberLength {
+		return personnummer.ErrInvalidFormat
+	}
+
+	sum := 0
+	for i, w := range weights {
+		sum += int(p.Number[i]-'0') * w
+	}
+
+	if (11-sum%11)%11 != int(p.Number[8]-'0') {
+		return personnummer.ErrInvalidChecksum
+	}
+
+	return nil
+}
+
+// Valid returns if the control digit is correct.
+func (p *Person) Valid() bool {
+	return p.Validate() == nil
+}
+
+// String returns the kennitala in the form DDMMYY-RRKC.
+func (p *Person) String() string {
+	if len(p.Number) != numberLength {
+		return p.Number
+	}
+
+	return p.Number[:6] + "-" + p.Number[6:]
+}
+
+// centuryFromDigit returns the century told by the last digit. It returns false
+// if the digit isn't used.
+func centuryFromDigit(digit byte) (int, bool) {
+	switch digit {
+	case '8':
+		return 1800, true
+	case '9':
+		return 1900, true
+	case '0':
+		return 2000, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,71 @@
+package is
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+func TestNewPerson(t *testing.T) {
+	cases := []struct {
+		input     string
+		date      string
+		isCompany bool
+		string    string
+		valid     bool
+		err       error
+	}{
+		{input: "120174-3399", date: "1974-01-12", string: "120174-3399", valid: true},
+		{input: "1201743399", date: "1974-01-12", string: "120174-3399", valid: true},
+		{input: "120174 2079", date: "1974-01-12", string: "120174-2079", valid: true},
+		{input: "290200-2020", date: "2000-02-29", string: "290200-2020", valid: true},
+		{input: "010185-2038", date: "1885-01-01", string: "010185-2038", valid: true},
+		{input: "410174-2009", date: "1974-01-01", isCompany: true, string: "410174-2009", valid: true},
+		{input: "120174-3389", date: "1974-01-12", string: "120174-3389", valid: false},
+		{input: "290200-2029", err: personnummer.ErrInvalidDate},
+		{input: "320174-3399", err: personnummer.ErrInvalidDate},
+		{input: "120174-3391", err: personnummer.ErrInvalidCentury},
+		{input: "120174-339", err: personnummer.ErrInvalidFormat},
+		{input: "120174+3399", err: personnummer.ErrInvalidFormat},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			p, err := NewPerson(tc.input)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "expected %v, got %v", tc.err, err)
+				assert.False(t, IsValidPerson(tc.input))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.date, p.Date.Format("2006-01-02"))
+			assert.Equal(t, tc.isCompany, p.IsCompany)
+			assert.Equal(t, tc.string, p.String())
+			assert.Equal(t, tc.valid, p.Valid())
+			assert.Equal(t, tc.valid, IsValidPerson(tc.input))
+		})
+	}
+}
+
+func TestPerson_DefaultLocation(t *testing.T) {
+	// Iceland keeps UTC all year, so 23:30 UTC on the 31st of December is
+	// still the 31st in Atlantic/Reykjavik but not in a location an hour ahead.
+	now := time.Date(2019, 12, 31, 23, 30, 0, 0, time.UTC)
+
+	p, err := NewPerson("010185-2038")
+	require.NoError(t, err)
+
+	assert.Equal(t, 134, p.AgeAt(now))
+
+	p, err = NewPersonWithOptions("010185-2038", Options{Location: time.FixedZone("", 60*60)})
+	require.NoError(t, err)
+
+	assert.Equal(t, 135, p.AgeAt(now))
+}
@@ -0,0 +1,33 @@
+package personnummer
+
+// Kind represents what kind of number a parsed string is.
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	KindPerson
+	KindCoordination
+	KindOrganization
+	KindSoleTrader
+	KindInterim
+)
+
+// String returns the name of the kind.
+func (k Kind) String() string {
+	switch k {
+	case KindUnknown:
+		return "Unknown"
+	case KindPerson:
+		return "Personal identity number"
+	case KindCoordination:
+		return "Coordination number"
+	case KindOrganization:
+		return "Organization number"
+	case KindSoleTrader:
+		return "Sole trader"
+	case KindInterim:
+		return "Interim number"
+	}
+
+	return "Unknown"
+}
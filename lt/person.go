@@ -0,0 +1,96 @@
+// Package lt parses and validates Lithuanian personal codes (asmens kodas).
+//
+// A personal code is eleven digits, GYYMMDDSSSC, in the same format as
+// Estonian personal codes, see package ee.
+package lt
+
+import (
+	"time"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+	"github.com/bombsimon/go-personnummer/internal/baltic"
+	"github.com/bombsimon/go-personnummer/internal/national"
+)
+
+// defaultLocation is the location used when calculating age if not set in the
+// options.
+// nolint: gochecknoglobal
+var defaultLocation = national.NewLocation("Europe/Vilnius")
+
+// Options holds settings used when creating a Person. The zero value is ready
+// to use and calculates age in Europe/Vilnius.
+type Options = national.Options
+
+// birth gives Person the age methods, see national.Birth.
+type birth = national.Birth
+
+// Person represents a parsed Lithuanian personal code. It has the same age
+// methods as personnummer.Person, such as Age and IsOfAge.
+type Person struct {
+	// Number is the eleven digits of the code.
+	Number string
+	Date   time.Time
+	Gender personnummer.Gender
+	Serial int
+
+	birth
+}
+
+// NewPerson parses and returns a pointer to a Person based on the input. An
+// error is returned if the input isn't in the right format or the date isn't
+// valid, use Valid or Validate to check the control digit.
+func NewPerson(input string) (*Person, error) {
+	return NewPersonWithOptions(input, Options{})
+}
+
+// NewPersonWithOptions works like NewPerson but uses the passed options.
+func NewPersonWithOptions(input string, options Options) (*Person, error) {
+	code, err := baltic.Decode(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Person{
+		Number: code.Number,
+		Date:   code.Date,
+		Gender: code.Gender,
+		Serial: code.Serial,
+		birth:  national.NewBirth(code.Date, options, defaultLocation),
+	}, nil
+}
+
+// IsValidPerson returns if the input is a valid personal code.
+func IsValidPerson(input string) bool {
+	p, err := NewPerson(input)
+	if err != nil {
+		return false
+	}
+
+	return p.Valid()
+}
+
+// Validate returns personnummer.ErrInvalidChecksum if the control digit is
+// wrong.
+func (p *Person) Validate() error {
+	return baltic.Code{Number: p.Number}.Validate()
+}
+
+// Valid returns if the control digit is correct.
+func (p *Person) Valid() bool {
+	return p.Validate() == nil
+}
+
+// String returns the personal code.
+func (p *Person) String() string {
+	return p.Number
+}
+
+// Male returns true if the person is a male.
+func (p *Person) Male() bool {
+	return p.Gender == personnummer.Male
+}
+
+// Female returns true if the person is a female.
+func (p *Person) Female() bool {
+	return p.Gender == personnummer.Female
+}
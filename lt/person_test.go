@@ -0,0 +1,66 @@
+package lt
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+func TestNewPerson(t *testing.T) {
+	cases := []struct {
+		input  string
+		date   string
+		gender personnummer.Gender
+		valid  bool
+		err    error
+	}{
+		{input: "33309240064", date: "1933-09-24", gender: personnummer.Male, valid: true},
+		{input: "60002291230", date: "2000-02-29", gender: personnummer.Female, valid: true},
+		{input: "33309240065", date: "1933-09-24", gender: personnummer.Male, valid: false},
+		{input: "33309310064", err: personnummer.ErrInvalidDate},
+		{input: "93309240064", err: personnummer.ErrInvalidCentury},
+		{input: "3330924006", err: personnummer.ErrInvalidFormat},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			p, err := NewPerson(tc.input)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "expected %v, got %v", tc.err, err)
+				assert.False(t, IsValidPerson(tc.input))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.date, p.Date.Format("2006-01-02"))
+			assert.Equal(t, tc.gender, p.Gender)
+			assert.Equal(t, tc.gender == personnummer.Male, p.Male())
+			assert.Equal(t, tc.gender == personnummer.Female, p.Female())
+			assert.Equal(t, tc.valid, p.Valid())
+			assert.Equal(t, tc.valid, IsValidPerson(tc.input))
+			assert.Equal(t, tc.input, p.String())
+		})
+	}
+}
+
+func TestPerson_DefaultLocation(t *testing.T) {
+	// 23:30 UTC on the 31st of December is already the 1st of January in
+	// Europe/Vilnius.
+	now := time.Date(2019, 12, 31, 23, 30, 0, 0, time.UTC)
+
+	p, err := NewPerson("38001010015")
+	require.NoError(t, err)
+
+	assert.Equal(t, 40, p.AgeAt(now))
+
+	p, err = NewPersonWithOptions("38001010015", Options{Location: time.UTC})
+	require.NoError(t, err)
+
+	assert.Equal(t, 39, p.AgeAt(now))
+}
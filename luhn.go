@@ -2,10 +2,10 @@ package personnummer
 
 import (
 	"errors"
-	"fmt"
-	"regexp"
 	"strconv"
-	"strings"
+
+	"github.com/bombsimon/go-personnummer/internal/codec"
+	"github.com/bombsimon/go-personnummer/luhn"
 )
 
 // Divider represents the divider between birth date and control digits.
@@ -19,6 +19,10 @@ const (
 
 // Parsed represents a parsed string. The fields are named as date parts but may
 // be of other types in case of an organisation number or coordination number.
+//
+// For interim numbers Letter holds the letter replacing the first serial digit
+// and Serial holds the serial number with the letter replaced by the digit it
+// stands for, see InterimLetterDigit.
 type Parsed struct {
 	Century      int
 	Year         int
@@ -27,108 +31,230 @@ type Parsed struct {
 	Serial       int
 	ControlDigit *int
 	Divider      Divider
-}
+	Kind         Kind
+	Letter       rune
 
-// nolint: gochecknoglobal
-var validFormatRe = regexp.MustCompile(`^(\d{2})?(\d{2})(\d{2})(\d{2})([-+])?(\d{3})(\d)?$`)
+	// hasCentury and hasDivider tells if the century and divider was part of
+	// the parsed input, used to report offsets in validation errors.
+	hasCentury bool
+	hasDivider bool
+
+	// inputOffsets maps indexes in the normalized input to the input in
+	// ModeLenient, see Options.normalize.
+	inputOffsets []int
+}
 
 // Parse will parse a string and returned a pointer to a Parsed type. If the
 // string passed isn't in a valid format an error will be returned.
+//
+// The accepted format is an optional two digit century, a six digit date, an
+// optional divider ('-' or '+'), a three digit serial number and an optional
+// control digit. If no control digit is given it will be calculated. The first
+// serial digit may be a letter for interim numbers, see InterimNumber.
+//
+// The Kind is set to the kind of number told by the format of the input. Use
+// Identify to also validate the input as that kind.
 func Parse(input string) (*Parsed, error) {
-	matches := validFormatRe.FindStringSubmatch(input)
+	return ParseWithOptions(input, Options{})
+}
+
+// ParseWithOptions works like Parse but uses the mode from the passed options.
+// In ModeLenient the input is normalized before parsing and in ModeStrict a
+// missing control digit is an error instead of being calculated.
+func ParseWithOptions(input string, options Options) (*Parsed, error) {
+	// Allocate the parsed value and control digit together to only allocate
+	// once.
+	p := &struct {
+		Parsed
+		controlDigit int
+	}{}
+
+	normalized, offsets := options.normalize(input)
+
+	cd, hasControlDigit, err := parseInto(normalized, &p.Parsed)
+	if err != nil {
+		var vErr *ValidationError
+		if errors.As(err, &vErr) {
+			vErr.Offset = inputOffset(offsets, vErr.Offset)
+		}
+
+		return nil, err
+	}
+
+	p.inputOffsets = offsets
 
-	if len(matches) != 8 {
-		return nil, errors.New("invalid format")
+	p.controlDigit = cd
+	p.ControlDigit = &p.controlDigit
+
+	if options.Mode == ModeStrict && !hasControlDigit {
+		return nil, p.validationError(ErrMissingControlDigit, PositionControlDigit, "", "")
 	}
 
+	return &p.Parsed, nil
+}
+
+// parseInto parses the input into p without allocating. The control digit is
+// returned instead of set on p, calculated if not part of the input, together
+// with if it was part of the input.
+func parseInto(input string, p *Parsed) (int, bool, error) {
 	var (
-		century, _ = strconv.Atoi(matches[1])
-		year, _    = strconv.Atoi(matches[2])
-		month, _   = strconv.Atoi(matches[3])
-		day, _     = strconv.Atoi(matches[4])
-		serial, _  = strconv.Atoi(matches[6])
-		divider    = Divider(strings.ToUpper(matches[5]))
+		dividerAt = -1
+		letterAt  = -1
+		date      string
+		serial    string
 	)
 
-	p := &Parsed{
-		Year:    year,
-		Month:   month,
-		Day:     day,
-		Serial:  serial,
-		Divider: divider,
+	for i := 0; i < len(input); i++ {
+		switch c := input[i]; {
+		case c >= '0' && c <= '9':
+		case (c == '-' || c == '+') && dividerAt == -1:
+			dividerAt = i
+		case (c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z') && letterAt == -1:
+			letterAt = i
+		default:
+			return 0, false, ErrInvalidFormat
+		}
 	}
 
-	if century > 0 {
-		p.Century = century * 100
+	if dividerAt >= 0 {
+		date, serial = input[:dividerAt], input[dividerAt+1:]
+	} else {
+		// Without divider the length tells if we have a century and control
+		// digit. Ten digits means no century but a control digit.
+		switch len(input) {
+		case 9, 10:
+			date, serial = input[:6], input[6:]
+		case 11, 12:
+			date, serial = input[:8], input[8:]
+		default:
+			return 0, false, ErrInvalidFormat
+		}
 	}
 
-	if p.Divider == DividerNone {
-		p.Divider = DividerMinus
+	if (len(date) != 6 && len(date) != 8) || (len(serial) != 3 && len(serial) != 4) {
+		return 0, false, ErrInvalidFormat
 	}
 
-	if cd, err := strconv.Atoi(matches[7]); err == nil {
-		p.ControlDigit = &cd
+	// A letter may only replace the first serial digit.
+	if letterAt >= 0 && letterAt != len(input)-len(serial) {
+		return 0, false, ErrInvalidFormat
 	}
 
-	if p.ControlDigit == nil {
-		cd := p.LuhnControlDigit(p.LuhnChecksum())
-		p.ControlDigit = &cd
+	*p = Parsed{
+		Divider:    DividerMinus,
+		hasDivider: dividerAt >= 0,
+		hasCentury: len(date) == 8,
 	}
 
-	return p, nil
-}
+	if dividerAt >= 0 && input[dividerAt] == '+' {
+		p.Divider = DividerPlus
+	}
 
-// LuhnCHecksum calculates the sum of the parsed digits with the Luhn algorithm.
-func (p *Parsed) LuhnChecksum() int {
-	var (
-		sum    = 0
-		digits = fmt.Sprintf("%02d%02d%02d%03d", p.Year, p.Month, p.Day, p.Serial)
-	)
+	if len(date) == 8 {
+		p.Century = atoi(date[:2]) * 100
+		date = date[2:]
+	}
 
-	for i := range digits {
-		digit, err := strconv.Atoi(string(digits[i]))
-		if err != nil {
-			panic("invalid luhn iteration value")
-		}
+	p.Year = atoi(date[:2])
+	p.Month = atoi(date[2:4])
+	p.Day = atoi(date[4:])
+	p.Serial = atoi(serial[:3])
 
-		if i%2 == 0 {
-			digit *= 2
-		}
+	if letterAt >= 0 {
+		letter := rune(serial[0] &^ ('a' - 'A'))
 
-		if digit > 9 {
-			digit -= 9
+		digit, _, ok := InterimLetterDigit(letter)
+		if !ok {
+			return 0, false, p.validationError(
+				ErrInvalidSerial, PositionSerial,
+				interimLetters+interimLettersVastraGotaland, string(letter),
+			)
 		}
 
-		sum += digit
+		p.Letter = letter
+		p.Serial = digit*100 + atoi(serial[1:3])
 	}
 
-	return sum
+	p.Kind = p.formatKind()
+
+	if len(serial) == 4 {
+		return atoi(serial[3:]), true, nil
+	}
+
+	return p.LuhnControlDigit(p.LuhnChecksum()), false, nil
 }
 
-// LuhnControlDigit calculates the control digit based on a checksum.
-func (p *Parsed) LuhnControlDigit(cs int) int {
-	checksum := 10 - (cs % 10)
+// formatKind returns the kind of number told by the format of the parsed
+// parts without validating them.
+func (p *Parsed) formatKind() Kind {
+	switch {
+	case p.Letter != 0, p.Century == stockholmReserveCentury:
+		return KindInterim
+	case p.Month >= 20:
+		return KindOrganization
+	case p.Century == 1600:
+		return KindSoleTrader
+	case p.Day > minCoordinationNumber:
+		return KindCoordination
+	}
+
+	return KindPerson
+}
+
+// digit returns the last digit of n as an ASCII digit.
+func digit(n int) byte {
+	return byte('0' + n%10)
+}
+
+// atoi converts a string of ASCII digits to an int.
+func atoi(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
+	}
 
-	if checksum == 10 {
-		return 0
+	return n
+}
+
+// LuhnChecksum calculates the sum of the parsed digits with the Luhn algorithm.
+func (p *Parsed) LuhnChecksum() int {
+	digits := [9]byte{
+		digit(p.Year / 10), digit(p.Year),
+		digit(p.Month / 10), digit(p.Month),
+		digit(p.Day / 10), digit(p.Day),
+		digit(p.Serial / 100), digit(p.Serial / 10), digit(p.Serial),
 	}
 
-	return checksum
+	// The digits are always valid so the error can be ignored.
+	sum, _ := luhn.Checksum(string(digits[:]))
+
+	return sum
+}
+
+// LuhnControlDigit calculates the control digit based on a checksum.
+func (p *Parsed) LuhnControlDigit(cs int) int {
+	return (10 - cs%10) % 10
 }
 
 // Valid returns if a parsed string is valid, that is if the given control digit
 // matches the checksum of the digits.
 func (p *Parsed) Valid() bool {
-	var (
-		controlDigit = p.LuhnControlDigit(p.LuhnChecksum())
-		cd           = controlDigit
-	)
+	return p.Validate() == nil
+}
+
+// Validate returns a ValidationError with ErrInvalidChecksum if the given
+// control digit doesn't match the checksum of the digits.
+func (p *Parsed) Validate() error {
+	controlDigit := p.LuhnControlDigit(p.LuhnChecksum())
 
-	if p.ControlDigit != nil {
-		cd = *p.ControlDigit
+	if p.ControlDigit != nil && *p.ControlDigit != controlDigit {
+		return p.validationError(
+			ErrInvalidChecksum, PositionControlDigit,
+			strconv.Itoa(controlDigit), strconv.Itoa(*p.ControlDigit),
+		)
 	}
 
-	return controlDigit == cd
+	return nil
 }
 
 // ValidPerson returns if a parsed string is valid if validated as a private
@@ -155,26 +281,5 @@ func (p *Parsed) ValidOrganization() bool {
 
 // stringFromInterface returns the string value from an interface.
 func stringFromInterface(input interface{}) string {
-	var nr string
-
-	switch v := input.(type) {
-	case string:
-		nr = v
-	case []byte:
-		nr = string(v)
-	case int:
-		nr = strconv.Itoa(v)
-	case int32:
-		nr = strconv.Itoa(int(v))
-	case int64:
-		nr = strconv.Itoa(int(v))
-	case float32:
-		nr = strconv.Itoa(int(v))
-	case float64:
-		nr = strconv.Itoa(int(v))
-	default:
-		nr = ""
-	}
-
-	return nr
+	return codec.StringFromInterface(input)
 }
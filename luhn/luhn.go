@@ -0,0 +1,84 @@
+// Package luhn implements the Luhn (mod 10) algorithm used for Swedish personal
+// identity numbers, organization numbers, OCR references, bankgiro and
+// plusgiro numbers and card numbers.
+//
+// All functions take the digits as a string which may only contain the
+// characters 0-9 and may be of any length.
+package luhn
+
+import (
+	"errors"
+)
+
+// ErrInvalidDigits is returned if the digits contain anything but 0-9.
+// nolint: gochecknoglobal
+var ErrInvalidDigits = errors.New("digits may only contain 0-9")
+
+// Checksum calculates the Luhn sum of the digits without a control digit.
+// Every other digit starting with the rightmost one is doubled and if the
+// result is above 9, 9 is subtracted before summing.
+func Checksum(digits string) (int, error) {
+	sum := 0
+
+	for i := len(digits) - 1; i >= 0; i-- {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			return 0, ErrInvalidDigits
+		}
+
+		digit := int(c - '0')
+
+		if (len(digits)-1-i)%2 == 0 {
+			digit *= 2
+
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+	}
+
+	return sum, nil
+}
+
+// ControlDigit calculates the control digit for the digits without a control
+// digit.
+func ControlDigit(digits string) (int, error) {
+	sum, err := Checksum(digits)
+	if err != nil {
+		return 0, err
+	}
+
+	return (10 - sum%10) % 10, nil
+}
+
+// Valid returns if the last digit is a correct control digit for the digits
+// before it. At least two digits are required.
+func Valid(digits string) bool {
+	if len(digits) < 2 {
+		return false
+	}
+
+	last := digits[len(digits)-1]
+	if last < '0' || last > '9' {
+		return false
+	}
+
+	cd, err := ControlDigit(digits[:len(digits)-1])
+	if err != nil {
+		return false
+	}
+
+	return cd == int(last-'0')
+}
+
+// Append returns the digits with the control digit appended.
+func Append(digits string) (string, error) {
+	cd, err := ControlDigit(digits)
+	if err != nil {
+		return "", err
+	}
+
+	return digits + string(rune('0'+cd)), nil
+}
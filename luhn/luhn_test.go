@@ -0,0 +1,99 @@
+package luhn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksum(t *testing.T) {
+	cases := []struct {
+		digits string
+		sum    int
+		err    error
+	}{
+		{digits: "", sum: 0},
+		{digits: "0", sum: 0},
+		{digits: "5", sum: 1},
+		{digits: "800101329", sum: 26},
+		{digits: "7992739871", sum: 67},
+		{digits: "80010a329", err: ErrInvalidDigits},
+		{digits: "800101-329", err: ErrInvalidDigits},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.digits, func(t *testing.T) {
+			sum, err := Checksum(tc.digits)
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.sum, sum)
+		})
+	}
+}
+
+func TestControlDigit(t *testing.T) {
+	cases := []struct {
+		digits       string
+		controlDigit int
+	}{
+		{digits: "800101329", controlDigit: 4},
+		{digits: "556703748", controlDigit: 5},
+		{digits: "7992739871", controlDigit: 3},
+		{digits: "1234567", controlDigit: 4},
+		{digits: "", controlDigit: 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.digits, func(t *testing.T) {
+			cd, err := ControlDigit(tc.digits)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.controlDigit, cd)
+		})
+	}
+
+	_, err := ControlDigit("12a")
+	assert.Equal(t, ErrInvalidDigits, err)
+}
+
+func TestValid(t *testing.T) {
+	cases := []struct {
+		digits string
+		valid  bool
+	}{
+		{digits: "8001013294", valid: true},
+		{digits: "5567037485", valid: true},
+		{digits: "5567037481", valid: false},
+		{digits: "79927398713", valid: true},
+		{digits: "4111111111111111", valid: true},
+		{digits: "4111111111111112", valid: false},
+		{digits: "00", valid: true},
+		{digits: "0", valid: false},
+		{digits: "", valid: false},
+		{digits: "800101329a", valid: false},
+		{digits: "800101-3294", valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.digits, func(t *testing.T) {
+			assert.Equal(t, tc.valid, Valid(tc.digits))
+		})
+	}
+}
+
+func TestAppend(t *testing.T) {
+	digits, err := Append("800101329")
+
+	require.NoError(t, err)
+	assert.Equal(t, "8001013294", digits)
+	assert.True(t, Valid(digits))
+
+	_, err = Append("1 2")
+	assert.Equal(t, ErrInvalidDigits, err)
+}
@@ -2,6 +2,8 @@ package personnummer
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -33,6 +35,7 @@ func TestParse(t *testing.T) {
 				Serial:       329,
 				ControlDigit: &four,
 				Divider:      DividerMinus,
+				Kind:         KindPerson,
 			},
 		},
 		{
@@ -45,6 +48,9 @@ func TestParse(t *testing.T) {
 				Serial:       329,
 				ControlDigit: &four,
 				Divider:      DividerPlus,
+				Kind:         KindPerson,
+				hasCentury:   true,
+				hasDivider:   true,
 			},
 		},
 		{
@@ -57,6 +63,7 @@ func TestParse(t *testing.T) {
 				Serial:       329,
 				ControlDigit: &four,
 				Divider:      DividerMinus,
+				Kind:         KindPerson,
 			},
 		},
 		{
@@ -69,6 +76,8 @@ func TestParse(t *testing.T) {
 				Serial:       660,
 				ControlDigit: &three,
 				Divider:      DividerMinus,
+				Kind:         KindPerson,
+				hasDivider:   true,
 			},
 		},
 	}
@@ -225,3 +234,57 @@ func TestStringFromInterface(t *testing.T) {
 		})
 	}
 }
+
+func TestParse_MatchesRegexp(t *testing.T) {
+	// The format previously used to parse input. Interim numbers with a letter
+	// are tested in TestParse_Interim.
+	re := regexp.MustCompile(`^(\d{2})?(\d{2})(\d{2})(\d{2})([-+])?(\d{3})(\d)?$`)
+
+	inputs := []string{
+		"8001013294", "800101-3294", "800101+3294", "800101-329",
+		"198001013294", "19800101-3294", "19800101+329", "1980010132",
+		"80010132", "8001013-294", "800101--3294", "800101-32945",
+		"19800101329", "800101-3a94", "80a101-3294", "", "-", "+3294",
+		"800101-",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			parsed, err := Parse(input)
+
+			matches := re.FindStringSubmatch(input)
+			if matches == nil {
+				assert.Equal(t, ErrInvalidFormat, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			century, _ := strconv.Atoi(matches[1])
+			year, _ := strconv.Atoi(matches[2])
+			month, _ := strconv.Atoi(matches[3])
+			day, _ := strconv.Atoi(matches[4])
+			serial, _ := strconv.Atoi(matches[6])
+
+			assert.Equal(t, century*100, parsed.Century)
+			assert.Equal(t, year, parsed.Year)
+			assert.Equal(t, month, parsed.Month)
+			assert.Equal(t, day, parsed.Day)
+			assert.Equal(t, serial, parsed.Serial)
+
+			if matches[7] != "" {
+				cd, _ := strconv.Atoi(matches[7])
+				assert.Equal(t, cd, *parsed.ControlDigit)
+			}
+		})
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse("19800101-3294")
+	}
+}
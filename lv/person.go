@@ -0,0 +1,160 @@
+// Package lv parses and validates Latvian personal codes (personas kods).
+//
+// A personal code is eleven digits, DDMMYY-CNNNX, where DDMMYY is the date of
+// birth, C tells the century, 0 for 1800, 1 for 1900 and 2 for 2000, NNN is a
+// serial number and X is a control digit. Personal codes issued since July
+// 2017 start with 32 and don't contain the date of birth. The personal code
+// doesn't tell the gender.
+package lv
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+	"github.com/bombsimon/go-personnummer/internal/national"
+)
+
+const (
+	// numberLength is the number of digits in a personal code.
+	numberLength = 11
+
+	// datelessPrefix is the prefix of personal codes without date of birth.
+	datelessPrefix = "32"
+)
+
+// weights are used to calculate the control digit.
+// nolint: gochecknoglobal
+var weights = [...]int{1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+
+// defaultLocation is the location used when calculating age if not set in the
+// options.
+// nolint: gochecknoglobal
+var defaultLocation = national.NewLocation("Europe/Riga")
+
+// Options holds settings used when creating a Person. The zero value is ready
+// to use and calculates age in Europe/Riga.
+type Options = national.Options
+
+// birth gives Person the age methods, see national.Birth.
+type birth = national.Birth
+
+// Person represents a parsed Latvian personal code. It has the same age methods
+// as personnummer.Person, such as Age and IsOfAge, which give the age 0 if the
+// personal code doesn't contain the date of birth.
+type Person struct {
+	// Number is the eleven digits of the code without dash.
+	Number string
+
+	// Date is the date of birth, the zero value if HasBirthDate is false.
+	Date time.Time
+
+	// HasBirthDate is false for personal codes issued since 2017 that don't
+	// contain the date of birth.
+	HasBirthDate bool
+
+	birth
+}
+
+// NewPerson parses and returns a pointer to a Person based on the input. The
+// input is eleven digits, optionally with a dash after the sixth digit. An
+// error is returned if the input isn't in the right format or the date isn't
+// valid, use Valid or Validate to check the control digit.
+func NewPerson(input string) (*Person, error) {
+	return NewPersonWithOptions(input, Options{})
+}
+
+// NewPersonWithOptions works like NewPerson but uses the passed options.
+func NewPersonWithOptions(input string, options Options) (*Person, error) {
+	nr := strings.TrimSpace(input)
+	if len(nr) == numberLength+1 && nr[6] == '-' {
+		nr = nr[:6] + nr[7:]
+	}
+
+	if len(nr) != numberLength {
+		return nil, personnummer.ErrInvalidFormat
+	}
+
+	for i := 0; i < len(nr); i++ {
+		if nr[i] < '0' || nr[i] > '9' {
+			return nil, personnummer.ErrInvalidFormat
+		}
+	}
+
+	p := &Person{
+		Number: nr,
+	}
+
+	if strings.HasPrefix(nr, datelessPrefix) {
+		return p, nil
+	}
+
+	centuryDigit := int(nr[6] - '0')
+	if centuryDigit > 2 {
+		return nil, personnummer.ErrInvalidCentury
+	}
+
+	var (
+		day, _   = strconv.Atoi(nr[0:2])
+		month, _ = strconv.Atoi(nr[2:4])
+		year, _  = strconv.Atoi(nr[4:6])
+	)
+
+	year += 1800 + centuryDigit*100
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day || int(date.Month()) != month {
+		return nil, personnummer.ErrInvalidDate
+	}
+
+	p.Date = date
+	p.HasBirthDate = true
+	p.birth = national.NewBirth(date, options, defaultLocation)
+
+	return p, nil
+}
+
+// IsValidPerson returns if the input is a valid personal code.
+func IsValidPerson(input string) bool {
+	p, err := NewPerson(input)
+	if err != nil {
+		return false
+	}
+
+	return p.Valid()
+}
+
+// Validate returns personnummer.ErrInvalidChecksum if the control digit is
+// wrong. The control digit is (1101 - the weighted sum of the first ten
+// digits) modulo 11, a remainder of 10 is never valid.
+func (p *Person) Validate() error {
+	if len(p.Number) != numberLength {
+		return personnummer.ErrInvalidFormat
+	}
+
+	sum := 0
+	for i, w := range weights {
+		sum += int(p.Number[i]-'0') * w
+	}
+
+	if (1101-sum)%11 != int(p.Number[10]-'0') {
+		return personnummer.ErrInvalidChecksum
+	}
+
+	return nil
+}
+
+// Valid returns if the control digit is correct.
+func (p *Person) Valid() bool {
+	return p.Validate() == nil
+}
+
+// String returns the personal code in the form DDMMYY-CNNNX.
+func (p *Person) String() string {
+	if len(p.Number) != numberLength {
+		return p.Number
+	}
+
+	return p.Number[:6] + "-" + p.Number[6:]
+}
@@ -0,0 +1,87 @@
+package lv
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+func TestNewPerson(t *testing.T) {
+	cases := []struct {
+		input        string
+		date         string
+		hasBirthDate bool
+		string       string
+		valid        bool
+		err          error
+	}{
+		{input: "161175-19997", date: "1975-11-16", hasBirthDate: true, string: "161175-19997", valid: true},
+		{input: "16117519997", date: "1975-11-16", hasBirthDate: true, string: "161175-19997", valid: true},
+		{input: "290200-21239", date: "2000-02-29", hasBirthDate: true, string: "290200-21239", valid: true},
+		{input: "010101-01230", date: "1801-01-01", hasBirthDate: true, string: "010101-01230", valid: true},
+		{input: "010101-01231", date: "1801-01-01", hasBirthDate: true, string: "010101-01231", valid: false},
+		{input: "010199-10000", date: "1999-01-01", hasBirthDate: true, string: "010199-10000", valid: false},
+		{input: "328673-00679", string: "328673-00679", valid: true},
+		{input: "320000-00008", string: "320000-00008", valid: true},
+		{input: "320000-00009", string: "320000-00009", valid: false},
+		{input: "290200-11239", err: personnummer.ErrInvalidDate},
+		{input: "161375-19997", err: personnummer.ErrInvalidDate},
+		{input: "161175-39997", err: personnummer.ErrInvalidCentury},
+		{input: "161175+19997", err: personnummer.ErrInvalidFormat},
+		{input: "161175-1999", err: personnummer.ErrInvalidFormat},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			p, err := NewPerson(tc.input)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "expected %v, got %v", tc.err, err)
+				assert.False(t, IsValidPerson(tc.input))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.hasBirthDate, p.HasBirthDate)
+			assert.Equal(t, tc.hasBirthDate, !p.Date.IsZero())
+
+			if tc.hasBirthDate {
+				assert.Equal(t, tc.date, p.Date.Format("2006-01-02"))
+			}
+
+			assert.Equal(t, tc.string, p.String())
+			assert.Equal(t, tc.valid, p.Valid())
+			assert.Equal(t, tc.valid, IsValidPerson(tc.input))
+		})
+	}
+}
+
+func TestPerson_DefaultLocation(t *testing.T) {
+	// 23:30 UTC on the 31st of December is already the 1st of January in
+	// Europe/Riga.
+	now := time.Date(2019, 12, 31, 23, 30, 0, 0, time.UTC)
+
+	p, err := NewPerson("010101-01230")
+	require.NoError(t, err)
+
+	assert.Equal(t, 219, p.AgeAt(now))
+
+	p, err = NewPersonWithOptions("010101-01230", Options{Location: time.UTC})
+	require.NoError(t, err)
+
+	assert.Equal(t, 218, p.AgeAt(now))
+}
+
+func TestPerson_AgeWithoutBirthDate(t *testing.T) {
+	p, err := NewPerson("328673-00679")
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, p.Age())
+	assert.Equal(t, personnummer.AgeDetail{}, p.AgeDetail())
+	assert.False(t, p.IsOfAge(18))
+}
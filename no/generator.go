@@ -0,0 +1,109 @@
+package no
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+	"github.com/bombsimon/go-personnummer/internal/national"
+)
+
+// nolint: gochecknoglobal
+var defaultGenerator = NewGenerator(nil)
+
+// Generator generates valid numbers from its own random source. Two
+// generators created with the same seed will generate the same numbers in the
+// same order. A Generator is safe for concurrent use and the zero value uses a
+// source seeded with the current time.
+type Generator struct {
+	rand national.Rand
+}
+
+// NewGenerator returns a new Generator using the passed source, or a source
+// seeded with the current time if nil.
+func NewGenerator(src rand.Source) *Generator {
+	return &Generator{
+		rand: national.Rand{Source: src},
+	}
+}
+
+// NewGeneratorWithSeed returns a new Generator using a source with the passed
+// seed.
+func NewGeneratorWithSeed(seed int64) *Generator {
+	return NewGenerator(rand.NewSource(seed))
+}
+
+// Generate will generate a valid Norwegian fødselsnummer based on the passed
+// date and gender.
+func Generate(date time.Time, gender personnummer.Gender) (*Person, error) {
+	return defaultGenerator.Person(date, gender)
+}
+
+// GenerateDNumber will generate a valid Norwegian D-number based on the passed
+// date and gender.
+func GenerateDNumber(date time.Time, gender personnummer.Gender) (*Person, error) {
+	return defaultGenerator.DNumber(date, gender)
+}
+
+// Person will generate a valid Norwegian fødselsnummer based on the passed date
+// and gender. Only dates between 1854 and 2039 can be generated.
+func (g *Generator) Person(date time.Time, gender personnummer.Gender) (*Person, error) {
+	return g.person(date, gender, false)
+}
+
+// DNumber will generate a valid Norwegian D-number based on the passed date and
+// gender. The day in the number is the day of the date + 40.
+func (g *Generator) DNumber(date time.Time, gender personnummer.Gender) (*Person, error) {
+	return g.person(date, gender, true)
+}
+
+// person generates a fødselsnummer or D-number.
+func (g *Generator) person(date time.Time, gender personnummer.Gender, dNumber bool) (*Person, error) {
+	if gender != personnummer.Male && gender != personnummer.Female {
+		return nil, personnummer.ErrInvalidGender
+	}
+
+	min, max, ok := individualRange(date.Year())
+	if !ok {
+		return nil, personnummer.ErrInvalidDate
+	}
+
+	day := date.Day()
+	if dNumber {
+		day += offset
+	}
+
+	for {
+		individual := min + g.rand.Intn(max-min+1)
+		if genderFromIndividual(individual) != gender {
+			continue
+		}
+
+		digits := fmt.Sprintf("%02d%02d%02d%03d", day, int(date.Month()), date.Year()%100, individual)
+
+		// Some individual numbers give a control digit of 10 and can't be
+		// used so we try another one.
+		k1, k2, ok := controlDigits(digits)
+		if !ok {
+			continue
+		}
+
+		return NewPerson(fmt.Sprintf("%s%d%d", digits, k1, k2))
+	}
+}
+
+// individualRange returns the range of individual numbers used for people
+// born in the year.
+func individualRange(year int) (int, int, bool) {
+	switch {
+	case year >= 1854 && year <= 1899:
+		return 500, 749, true
+	case year >= 1900 && year <= 1999:
+		return 0, 499, true
+	case year >= 2000 && year <= 2039:
+		return 500, 999, true
+	}
+
+	return 0, 0, false
+}
@@ -0,0 +1,51 @@
+package no
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+func TestGenerate(t *testing.T) {
+	dates := []time.Time{
+		time.Date(1860, 6, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1950, 12, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2039, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, date := range dates {
+		for _, gender := range []personnummer.Gender{personnummer.Male, personnummer.Female} {
+			p, err := Generate(date, gender)
+			require.NoError(t, err)
+
+			assert.True(t, p.Valid(), p.Number)
+			assert.Equal(t, date, p.Date)
+			assert.Equal(t, gender, p.Gender)
+			assert.False(t, p.IsDNumber)
+
+			p, err = GenerateDNumber(date, gender)
+			require.NoError(t, err)
+
+			assert.True(t, p.Valid(), p.Number)
+			assert.Equal(t, date, p.Date)
+			assert.Equal(t, gender, p.Gender)
+			assert.True(t, p.IsDNumber)
+		}
+	}
+}
+
+func TestGenerate_Invalid(t *testing.T) {
+	_, err := Generate(time.Date(1850, 1, 1, 0, 0, 0, 0, time.UTC), personnummer.Male)
+	assert.Equal(t, personnummer.ErrInvalidDate, err)
+
+	_, err = Generate(time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC), personnummer.Male)
+	assert.Equal(t, personnummer.ErrInvalidDate, err)
+
+	_, err = Generate(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), personnummer.Gender(3))
+	assert.Equal(t, personnummer.ErrInvalidGender, err)
+}
@@ -0,0 +1,238 @@
+// Package no parses, validates and generates Norwegian national identity
+// numbers; fødselsnummer, D-nummer and H-nummer.
+//
+// A number is eleven digits, DDMMYYIIIKK, where DDMMYY is the date of birth,
+// III is the individual number and KK are two control digits calculated with
+// mod 11. The century is told by the individual number and the year. A
+// D-number, given to people temporarily in Norway, has 40 added to the day and
+// an H-number, an auxiliary number used by the health services, has 40 added to
+// the month.
+package no
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+	"github.com/bombsimon/go-personnummer/internal/national"
+)
+
+const (
+	// numberLength is the number of digits in a number.
+	numberLength = 11
+
+	// offset is added to the day in a D-number and the month in an H-number.
+	offset = 40
+)
+
+// Weights used to calculate the two control digits.
+// nolint: gochecknoglobal
+var (
+	firstWeights  = [...]int{3, 7, 6, 1, 8, 9, 4, 5, 2}
+	secondWeights = [...]int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+)
+
+// defaultLocation is the location used when calculating age if not set in the
+// options.
+// nolint: gochecknoglobal
+var defaultLocation = national.NewLocation("Europe/Oslo")
+
+// Options holds settings used when creating a Person. The zero value is ready
+// to use and calculates age in Europe/Oslo.
+type Options = national.Options
+
+// birth gives Person the age methods, see national.Birth.
+type birth = national.Birth
+
+// Person represents a parsed Norwegian national identity number. It has the
+// same age methods as personnummer.Person, such as Age and IsOfAge.
+type Person struct {
+	// Number is the eleven digits of the number, DDMMYYIIIKK.
+	Number string
+
+	// Date is the date of birth, the day and month without any D-number or
+	// H-number offset.
+	Date       time.Time
+	Individual int
+	Gender     personnummer.Gender
+	IsDNumber  bool
+	IsHNumber  bool
+
+	birth
+}
+
+// NewPerson parses and returns a pointer to a Person based on the input. The
+// input is eleven digits, optionally with a space or dash after the date. An
+// error is returned if the input isn't in the right format or the date can't
+// be resolved, use Valid or Validate to check the control digits.
+func NewPerson(input string) (*Person, error) {
+	return NewPersonWithOptions(input, Options{})
+}
+
+// NewPersonWithOptions works like NewPerson but uses the passed options.
+func NewPersonWithOptions(input string, options Options) (*Person, error) {
+	nr := strings.TrimSpace(input)
+	if len(nr) == numberLength+1 && (nr[6] == ' ' || nr[6] == '-') {
+		nr = nr[:6] + nr[7:]
+	}
+
+	if len(nr) != numberLength {
+		return nil, personnummer.ErrInvalidFormat
+	}
+
+	for i := 0; i < len(nr); i++ {
+		if nr[i] < '0' || nr[i] > '9' {
+			return nil, personnummer.ErrInvalidFormat
+		}
+	}
+
+	var (
+		day, _        = strconv.Atoi(nr[0:2])
+		month, _      = strconv.Atoi(nr[2:4])
+		year, _       = strconv.Atoi(nr[4:6])
+		individual, _ = strconv.Atoi(nr[6:9])
+	)
+
+	p := &Person{
+		Number:     nr,
+		Individual: individual,
+		Gender:     genderFromIndividual(individual),
+		IsDNumber:  day > offset,
+		IsHNumber:  month > offset,
+	}
+
+	if p.IsDNumber && p.IsHNumber {
+		return nil, personnummer.ErrInvalidDate
+	}
+
+	if p.IsDNumber {
+		day -= offset
+	}
+
+	if p.IsHNumber {
+		month -= offset
+	}
+
+	century, ok := centuryFromIndividual(individual, year)
+	if !ok {
+		return nil, personnummer.ErrInvalidSerial
+	}
+
+	date := time.Date(century+year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day || int(date.Month()) != month {
+		return nil, personnummer.ErrInvalidDate
+	}
+
+	p.Date = date
+	p.birth = national.NewBirth(date, options, defaultLocation)
+
+	return p, nil
+}
+
+// IsValidPerson returns if the input is a valid fødselsnummer, D-number or
+// H-number.
+func IsValidPerson(input string) bool {
+	p, err := NewPerson(input)
+	if err != nil {
+		return false
+	}
+
+	return p.Valid()
+}
+
+// Validate returns personnummer.ErrInvalidChecksum if any of the control
+// digits are wrong or personnummer.ErrInvalidFormat if the person wasn't
+// created by parsing a number.
+func (p *Person) Validate() error {
+	if len(p.Number) != numberLength {
+		return personnummer.ErrInvalidFormat
+	}
+
+	k1, k2, ok := controlDigits(p.Number[:9])
+	if !ok || p.Number[9:] != strconv.Itoa(k1)+strconv.Itoa(k2) {
+		return personnummer.ErrInvalidChecksum
+	}
+
+	return nil
+}
+
+// Valid returns if the control digits are correct.
+func (p *Person) Valid() bool {
+	return p.Validate() == nil
+}
+
+// String returns the number as eleven digits, DDMMYYIIIKK.
+func (p *Person) String() string {
+	return p.Number
+}
+
+// Male returns true if the person is a male.
+func (p *Person) Male() bool {
+	return p.Gender == personnummer.Male
+}
+
+// Female returns true if the person is a female.
+func (p *Person) Female() bool {
+	return p.Gender == personnummer.Female
+}
+
+// centuryFromIndividual returns the century told by the individual number and
+// the two digit year. It returns false if the combination isn't used.
+//
+//   - 000-499 is used for 1900-1999.
+//   - 500-749 is used for 1854-1899.
+//   - 500-999 is used for 2000-2039.
+//   - 900-999 is used for 1940-1999.
+func centuryFromIndividual(individual, year int) (int, bool) {
+	switch {
+	case individual < 500:
+		return 1900, true
+	case individual < 750 && year >= 54:
+		return 1800, true
+	case year < 40:
+		return 2000, true
+	case individual >= 900:
+		return 1900, true
+	}
+
+	return 0, false
+}
+
+// genderFromIndividual returns the gender from the last digit of the
+// individual number, odd for males and even for females.
+func genderFromIndividual(individual int) personnummer.Gender {
+	if individual%2 == 1 {
+		return personnummer.Male
+	}
+
+	return personnummer.Female
+}
+
+// controlDigits returns the two control digits for the first nine digits. It
+// returns false if any of them would be 10 which means the digits can't form a
+// valid number.
+func controlDigits(digits string) (int, int, bool) {
+	k1 := mod11(digits, firstWeights[:])
+	if k1 == 10 {
+		return 0, 0, false
+	}
+
+	k2 := mod11(digits+strconv.Itoa(k1), secondWeights[:])
+	if k2 == 10 {
+		return 0, 0, false
+	}
+
+	return k1, k2, true
+}
+
+// mod11 returns the control digit for the digits with the weights, 11 minus
+// the weighted sum modulo 11 where 11 is 0.
+func mod11(digits string, weights []int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += int(digits[i]-'0') * w
+	}
+
+	return (11 - sum%11) % 11
+}
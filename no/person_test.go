@@ -0,0 +1,92 @@
+package no
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	personnummer "github.com/bombsimon/go-personnummer"
+)
+
+func TestNewPerson(t *testing.T) {
+	cases := []struct {
+		input   string
+		date    string
+		gender  personnummer.Gender
+		dNumber bool
+		hNumber bool
+		valid   bool
+		err     error
+	}{
+		{input: "01018012371", date: "1980-01-01", gender: personnummer.Male, valid: true},
+		{input: "010180 12371", date: "1980-01-01", gender: personnummer.Male, valid: true},
+		{input: "010180-12452", date: "1980-01-01", gender: personnummer.Female, valid: true},
+		{input: "01018012533", date: "1980-01-01", gender: personnummer.Male, valid: true},
+		{input: "01018012372", date: "1980-01-01", gender: personnummer.Male, valid: false},
+		{input: "15050550025", date: "2005-05-15", gender: personnummer.Female, valid: true},
+		{input: "15050550106", date: "2005-05-15", gender: personnummer.Male, valid: true},
+		{input: "29020050088", date: "2000-02-29", gender: personnummer.Female, valid: true},
+		{input: "01016090073", date: "1960-01-01", gender: personnummer.Female, valid: true},
+		{input: "01015560041", date: "1855-01-01", gender: personnummer.Female, valid: true},
+		{input: "41018012365", date: "1980-01-01", gender: personnummer.Male, dNumber: true, valid: true},
+		{input: "01418012354", date: "1980-01-01", gender: personnummer.Male, hNumber: true, valid: true},
+		{input: "41418012300", err: personnummer.ErrInvalidDate},
+		{input: "30020012300", err: personnummer.ErrInvalidDate},
+		{input: "29020150000", err: personnummer.ErrInvalidDate},
+		{input: "01014580000", err: personnummer.ErrInvalidSerial},
+		{input: "01018080000", err: personnummer.ErrInvalidSerial},
+		{input: "0101801237", err: personnummer.ErrInvalidFormat},
+		{input: "01018012A71", err: personnummer.ErrInvalidFormat},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			p, err := NewPerson(tc.input)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "expected %v, got %v", tc.err, err)
+				assert.False(t, IsValidPerson(tc.input))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.date, p.Date.Format("2006-01-02"))
+			assert.Equal(t, tc.gender, p.Gender)
+			assert.Equal(t, tc.gender == personnummer.Male, p.Male())
+			assert.Equal(t, tc.gender == personnummer.Female, p.Female())
+			assert.Equal(t, tc.dNumber, p.IsDNumber)
+			assert.Equal(t, tc.hNumber, p.IsHNumber)
+			assert.Equal(t, tc.valid, p.Valid())
+			assert.Equal(t, tc.valid, IsValidPerson(tc.input))
+			assert.Len(t, p.String(), 11)
+		})
+	}
+}
+
+func TestPerson_Validate(t *testing.T) {
+	// 311299999 gives a control digit of 10 and can't be valid.
+	p, err := NewPerson("31129999900")
+	require.NoError(t, err)
+
+	assert.Equal(t, personnummer.ErrInvalidChecksum, p.Validate())
+	assert.Equal(t, personnummer.ErrInvalidFormat, (&Person{}).Validate())
+}
+
+func TestPerson_DefaultLocation(t *testing.T) {
+	// 23:30 UTC on the 31st of December is already the 1st of January in
+	// Europe/Oslo.
+	now := time.Date(2019, 12, 31, 23, 30, 0, 0, time.UTC)
+
+	p, err := NewPerson("01018012371")
+	require.NoError(t, err)
+
+	assert.Equal(t, 40, p.AgeAt(now))
+
+	p, err = NewPersonWithOptions("01018012371", Options{Location: time.UTC})
+	require.NoError(t, err)
+
+	assert.Equal(t, 39, p.AgeAt(now))
+}
@@ -0,0 +1,145 @@
+package ocr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		description string
+		ref         string
+		options     Options
+		err         error
+	}{
+		{
+			description: "soft, valid",
+			ref:         "123455",
+		},
+		{
+			description: "soft, invalid control digit",
+			ref:         "123456",
+			err:         ErrInvalidChecksum,
+		},
+		{
+			description: "soft, length digit not checked",
+			ref:         "12345678903",
+		},
+		{
+			description: "soft, with length check",
+			ref:         "12345678903",
+			options:     Options{LengthCheck: true},
+			err:         ErrInvalidLengthDigit,
+		},
+		{
+			description: "too short",
+			ref:         "5",
+			err:         ErrInvalidLength,
+		},
+		{
+			description: "too long",
+			ref:         "12345678901234567890123455",
+			err:         ErrInvalidLength,
+		},
+		{
+			description: "not only digits",
+			ref:         "1234 55",
+			err:         ErrInvalidFormat,
+		},
+		{
+			description: "hard, valid length",
+			ref:         "123455",
+			options:     Options{Mode: ModeHard, Lengths: []int{6, 8}},
+		},
+		{
+			description: "hard, invalid length",
+			ref:         "123455",
+			options:     Options{Mode: ModeHard, Lengths: []int{7, 8}},
+			err:         ErrInvalidLength,
+		},
+		{
+			description: "hard, missing lengths",
+			ref:         "123455",
+			options:     Options{Mode: ModeHard},
+			err:         ErrMissingLengths,
+		},
+		{
+			description: "variable, valid",
+			ref:         "123456789023",
+			options:     Options{Mode: ModeVariable},
+		},
+		{
+			description: "variable, short with length digit",
+			ref:         "737",
+			options:     Options{Mode: ModeVariable},
+		},
+		{
+			description: "variable, invalid length digit",
+			ref:         "12345678903",
+			options:     Options{Mode: ModeVariable},
+			err:         ErrInvalidLengthDigit,
+		},
+		{
+			description: "variable, no room for length digit",
+			ref:         "75",
+			options:     Options{Mode: ModeVariable},
+			err:         ErrInvalidLengthDigit,
+		},
+		{
+			description: "variable, invalid control digit",
+			ref:         "123456789022",
+			options:     Options{Mode: ModeVariable},
+			err:         ErrInvalidChecksum,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := Validate(tc.ref, tc.options)
+
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.err == nil, Valid(tc.ref, tc.options))
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	cases := []struct {
+		base       string
+		withLength bool
+		ref        string
+		err        error
+	}{
+		{base: "12345", ref: "123455"},
+		{base: "12345", withLength: true, ref: "1234574"},
+		{base: "1234567890", withLength: true, ref: "123456789023"},
+		{base: "7", withLength: true, ref: "737"},
+		{base: "", err: ErrInvalidLength},
+		{base: "123456789012345678901234", ref: "1234567890123456789012340"},
+		{base: "123456789012345678901234", withLength: true, err: ErrInvalidLength},
+		{base: "12a", err: ErrInvalidFormat},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.base, func(t *testing.T) {
+			ref, err := Generate(tc.base, tc.withLength)
+			if tc.err != nil {
+				assert.Equal(t, tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.ref, ref)
+
+			mode := ModeSoft
+			if tc.withLength {
+				mode = ModeVariable
+			}
+
+			assert.NoError(t, Validate(ref, Options{Mode: mode}))
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package personnummer
+
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Mode represents how strict input is parsed.
+type Mode int
+
+const (
+	// ModeDefault accepts the formats accepted by Parse.
+	ModeDefault Mode = iota
+	// ModeStrict works like ModeDefault but also requires the control digit
+	// to be present.
+	ModeStrict
+	// ModeLenient normalizes the input before parsing it. Whitespace is
+	// removed, unicode dashes are replaced with '-', full-width digits are
+	// replaced with their ASCII counterpart and any surrounding characters
+	// that aren't digits are removed, e.g. a leading "pnr:" or a trailing
+	// name. A person given with a 16 prefix will get the century resolved as
+	// if no century was given.
+	ModeLenient
+)
+
+// Options holds settings used when creating types from an input string. The
+// zero value is ready to use and gives the same result as the functions
+// without options.
+type Options struct {
+	// Now returns the reference time used when resolving the century for
+	// numbers without one and when calculating age. Defaults to time.Now.
+	Now func() time.Time
+
+	// Mode sets how strict the input is parsed. Defaults to ModeDefault.
+	Mode Mode
+
+	// Location is the location used to tell the date when calculating age.
+	// Defaults to DefaultLocation, Europe/Stockholm.
+	Location *time.Location
+}
+
+// now returns the reference time for the options.
+func (o Options) now() time.Time {
+	if o.Now == nil {
+		return time.Now()
+	}
+
+	return o.Now()
+}
+
+// normalize returns the input normalized according to the mode. In
+// ModeLenient the index in the input of each byte in the normalized input is
+// also returned, with one extra index for the end of the normalized input, so
+// offsets in validation errors can be reported relative to the input.
+func (o Options) normalize(input string) (string, []int) {
+	if o.Mode != ModeLenient {
+		return input, nil
+	}
+
+	var (
+		runes   []rune
+		indexes []int
+		i       int
+	)
+
+	for _, r := range input {
+		index := i
+		i++
+
+		switch {
+		case unicode.IsSpace(r):
+			continue
+		case r >= '０' && r <= '９':
+			r = '0' + (r - '０')
+		case r == '＋':
+			r = '+'
+		case r == '−', unicode.Is(unicode.Pd, r):
+			r = '-'
+		}
+
+		runes = append(runes, r)
+		indexes = append(indexes, index)
+	}
+
+	// The letter in an interim number is never first or last so everything
+	// that isn't a digit can be trimmed.
+	isDigit := func(r rune) bool { return r >= '0' && r <= '9' }
+
+	start, end := 0, len(runes)
+	for start < end && !isDigit(runes[start]) {
+		start++
+	}
+
+	for end > start && !isDigit(runes[end-1]) {
+		end--
+	}
+
+	var (
+		sb      strings.Builder
+		offsets = make([]int, 0, end-start+1)
+	)
+
+	for j := start; j < end; j++ {
+		sb.WriteRune(runes[j])
+
+		for k := 0; k < utf8.RuneLen(runes[j]); k++ {
+			offsets = append(offsets, indexes[j])
+		}
+	}
+
+	if end > start {
+		offsets = append(offsets, indexes[end-1]+1)
+	} else {
+		offsets = append(offsets, 0)
+	}
+
+	return sb.String(), offsets
+}
+
+// inputOffset returns the index in the input for the index in the normalized
+// input, see normalize.
+func inputOffset(offsets []int, i int) int {
+	if offsets == nil {
+		return i
+	}
+
+	if i >= len(offsets) {
+		i = len(offsets) - 1
+	}
+
+	return offsets[i]
+}
@@ -0,0 +1,176 @@
+package personnummer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWithOptions(t *testing.T) {
+	cases := []struct {
+		description string
+		input       string
+		mode        Mode
+		expected    string
+		wantErr     error
+	}{
+		{
+			description: "default without control digit",
+			input:       "800101-329",
+			mode:        ModeDefault,
+			expected:    "800101-3294",
+		},
+		{
+			description: "strict without control digit",
+			input:       "800101-329",
+			mode:        ModeStrict,
+			wantErr:     ErrMissingControlDigit,
+		},
+		{
+			description: "strict with control digit",
+			input:       "800101-3294",
+			mode:        ModeStrict,
+			expected:    "800101-3294",
+		},
+		{
+			description: "default with space",
+			input:       "800101 3294",
+			mode:        ModeDefault,
+			wantErr:     ErrInvalidFormat,
+		},
+		{
+			description: "lenient with space",
+			input:       "800101 3294",
+			mode:        ModeLenient,
+			expected:    "800101-3294",
+		},
+		{
+			description: "lenient with surrounding whitespace and en-dash",
+			input:       "  800101–3294\n",
+			mode:        ModeLenient,
+			expected:    "800101-3294",
+		},
+		{
+			description: "lenient with full-width digits",
+			input:       "８００１０１－３２９４",
+			mode:        ModeLenient,
+			expected:    "800101-3294",
+		},
+		{
+			description: "lenient with surrounding noise",
+			input:       `"(800101+3294)".`,
+			mode:        ModeLenient,
+			expected:    "800101+3294",
+		},
+		{
+			description: "lenient with leading label",
+			input:       "pnr: 800101-3294",
+			mode:        ModeLenient,
+			expected:    "800101-3294",
+		},
+		{
+			description: "lenient with trailing name",
+			input:       "800101-3294 (Kalle)",
+			mode:        ModeLenient,
+			expected:    "800101-3294",
+		},
+		{
+			description: "lenient interim number with noise",
+			input:       "Reservnr: 800101-T124.",
+			mode:        ModeLenient,
+			expected:    "800101-T124",
+		},
+		{
+			description: "lenient with garbage",
+			input:       "80O101-3294",
+			mode:        ModeLenient,
+			wantErr:     ErrInvalidFormat,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			parsed, err := ParseWithOptions(tc.input, Options{Mode: tc.mode})
+
+			if tc.wantErr != nil {
+				assert.True(t, errors.Is(err, tc.wantErr), "got %v", err)
+				assert.Nil(t, parsed)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, parsed.Format(FormatShort))
+		})
+	}
+}
+
+func TestNewPersonWithOptions_Lenient(t *testing.T) {
+	person, err := NewPersonWithOptions("16 800101 3294", Options{Mode: ModeLenient})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1900, person.Century)
+	assert.True(t, person.Valid())
+
+	org, err := NewOrganizationWithOptions(" 16556703 7485 ", Options{Mode: ModeLenient})
+	require.NoError(t, err)
+
+	assert.True(t, org.Valid())
+}
+
+func TestParseWithOptions_LenientOffset(t *testing.T) {
+	cases := []struct {
+		description string
+		input       string
+		reason      error
+		offset      int
+	}{
+		{
+			description: "leading label",
+			input:       "pnr: 800101-3295",
+			reason:      ErrInvalidChecksum,
+			offset:      15,
+		},
+		{
+			description: "full-width digits",
+			input:       "８００１０１－３２９５",
+			reason:      ErrInvalidChecksum,
+			offset:      10,
+		},
+		{
+			description: "whitespace",
+			input:       " 8001 01 3295",
+			reason:      ErrInvalidChecksum,
+			offset:      12,
+		},
+		{
+			description: "invalid date after label",
+			input:       "pnr: 19801301-3294",
+			reason:      ErrInvalidDate,
+			offset:      9,
+		},
+		{
+			description: "unknown interim letter after label",
+			input:       "Reservnr: 800101-Q124",
+			reason:      ErrInvalidSerial,
+			offset:      17,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			parsed, err := ParseWithOptions(tc.input, Options{Mode: ModeLenient})
+			if err == nil {
+				err = (&Person{Parsed: parsed}).Validate()
+			}
+
+			var vErr *ValidationError
+
+			require.True(t, errors.As(err, &vErr), "got %v", err)
+			assert.True(t, errors.Is(err, tc.reason))
+			assert.Equal(t, tc.offset, vErr.Offset)
+		})
+	}
+}
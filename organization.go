@@ -1,5 +1,10 @@
 package personnummer
 
+import (
+	"fmt"
+	"strconv"
+)
+
 // CorporateForm indicates what form the company is of. This could be told by
 // reading the first digit in the organization number. This is not 100%
 // guaranteed to be correct according to Bolagsverket and Bolagsverket is also a
@@ -58,7 +63,13 @@ type Organization struct {
 // NewOrganization parses and returns a pointer to an Organization based on the
 // input. If the input cannot be parsed an error will be returned.
 func NewOrganization(input string) (*Organization, error) {
-	parsed, err := Parse(input)
+	return NewOrganizationWithOptions(input, Options{})
+}
+
+// NewOrganizationWithOptions works like NewOrganization but uses the mode from
+// the passed options when parsing the input.
+func NewOrganizationWithOptions(input string, options Options) (*Organization, error) {
+	parsed, err := ParseWithOptions(input, options)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +81,10 @@ func NewOrganization(input string) (*Organization, error) {
 // may be used to skip parsing multiple times if a string should be tested as
 // Parsed, Organization or Person.
 func NewOrganizationFromParsed(parsed *Parsed) (*Organization, error) {
+	if parsed.Kind == KindInterim {
+		return nil, ErrInvalidFormat
+	}
+
 	organisation := &Organization{
 		Parsed:        parsed,
 		CorporateForm: CorporateForm(parsed.Year / 10),
@@ -92,25 +107,33 @@ func IsValidOrganization(input interface{}) bool {
 
 // Valid returns if the parsed organization string is valid.
 func (o *Organization) Valid() bool {
+	return o.Validate() == nil
+}
+
+// Validate returns a ValidationError describing the first rule the parsed
+// organization string fails to validate against or nil if it's valid.
+func (o *Organization) Validate() error {
 	// May only be prefixed with 16.
 	if o.Century != 0 && o.Century != 1600 {
-		return false
+		return o.validationError(
+			ErrInvalidCentury, PositionCentury, "16", fmt.Sprintf("%02d", o.Century/100),
+		)
 	}
 
 	// Third digit ("month") must be >= 2
 	if o.Month < 20 {
-		return false
+		return o.validationError(ErrInvalidThirdDigit, PositionMonth, "2-9", strconv.Itoa(o.Month/10))
 	}
 
 	// Organization numbers may never be divided with `+`.
 	if o.Divider == DividerPlus {
-		return false
+		return o.validationError(ErrInvalidDivider, PositionDivider, string(DividerMinus), string(o.Divider))
 	}
 
 	// May never start with leading 0.
 	if o.Year < 10 {
-		return false
+		return o.validationError(ErrInvalidFirstDigit, PositionYear, "1-9", "0")
 	}
 
-	return o.Parsed.Valid()
+	return o.Parsed.Validate()
 }
@@ -1,11 +1,7 @@
 package personnummer
 
 import (
-	"errors"
 	"fmt"
-	"math"
-	"math/rand"
-	"strconv"
 	"time"
 )
 
@@ -50,6 +46,8 @@ type Person struct {
 	County         County
 	Gender         Gender
 	Zodiac         Zodiac
+
+	options Options
 }
 
 // GetDay will return the day as a valid date day, meaning it's the parsed
@@ -62,21 +60,51 @@ func (p *Person) GetDay() int {
 // input cannot be parsed an error will be returned. Upon creating a Person the
 // century, date and county will be set.
 func NewPerson(input string) (*Person, error) {
-	parsed, err := Parse(input)
+	return NewPersonWithOptions(input, Options{})
+}
+
+// NewPersonWithOptions works like NewPerson but uses the passed options, e.g.
+// to resolve the century against a fixed reference date or to parse the input
+// leniently.
+func NewPersonWithOptions(input string, options Options) (*Person, error) {
+	parsed, err := ParseWithOptions(input, options)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewPersonFromParsed(parsed)
+	if options.Mode == ModeLenient && parsed.Century == 1600 {
+		parsed.Century = 0
+	}
+
+	return NewPersonFromParsedWithOptions(parsed, options)
 }
 
 // NewPersonFromParsed returns a new person from a Parsed type. This may be used
 // to skip parsing multiple times if a string should be tested as Parsed,
 // Organization or Person.
 func NewPersonFromParsed(parsed *Parsed) (*Person, error) {
+	return NewPersonFromParsedWithOptions(parsed, Options{})
+}
+
+// NewPersonFromParsedWithOptions works like NewPersonFromParsed but uses the
+// passed options. The options are kept on the person and used by methods such
+// as SetCentury and Age. Interim numbers can't be used as a Person, use
+// NewInterimNumberFromParsed for those.
+func NewPersonFromParsedWithOptions(parsed *Parsed, options Options) (*Person, error) {
+	if parsed.Kind == KindInterim {
+		return nil, ErrInvalidFormat
+	}
+
+	return newPerson(parsed, options)
+}
+
+// newPerson returns a new person from a Parsed type, setting the century,
+// date, zodiac and county.
+func newPerson(parsed *Parsed, options Options) (*Person, error) {
 	person := &Person{
-		Parsed: parsed,
-		Gender: GenderFromSerial(parsed.Serial),
+		Parsed:  parsed,
+		Gender:  GenderFromSerial(parsed.Serial),
+		options: options,
 	}
 
 	if person.Day > minCoordinationNumber {
@@ -104,37 +132,82 @@ func NewPersonFromParsed(parsed *Parsed) (*Person, error) {
 
 // IsValidPerson returns if the parsed person string is valid.
 func IsValidPerson(input interface{}) bool {
-	nr := stringFromInterface(input)
+	var parsed Parsed
 
-	person, err := NewPerson(nr)
-	if err != nil {
+	// Validate without creating a Person to not allocate.
+	cd, _, err := parseInto(stringFromInterface(input), &parsed)
+	if err != nil || parsed.Kind == KindInterim {
 		return false
 	}
 
-	return person.Valid()
+	parsed.ControlDigit = &cd
+
+	return validatePerson(&parsed, time.Now()) == nil
+}
+
+// validatePerson validates the parsed value as a person with the same rules
+// as when creating and validating a Person but without allocating on success.
+func validatePerson(p *Parsed, t time.Time) error {
+	if err := p.personCenturyError(); err != nil {
+		return err
+	}
+
+	century := p.Century
+	if century == 0 {
+		c, ok := resolveCentury(p.Year, p.Month, p.Day%minCoordinationNumber, p.Divider, t)
+		if !ok {
+			return p.dateError()
+		}
+
+		century = c
+	}
+
+	if !validDate(century+p.Year, p.Month, p.Day%minCoordinationNumber) {
+		return p.dateError()
+	}
+
+	if century+p.Year <= 1990 {
+		if _, err := CountyFromSerial(p.Serial); err != nil {
+			return err
+		}
+	}
+
+	return p.Validate()
 }
 
 // Valid returns if the parsed person string is valid.
 func (p *Person) Valid() bool {
+	return p.Validate() == nil
+}
+
+// Validate returns a ValidationError describing the first rule the parsed
+// person string fails to validate against or nil if it's valid.
+func (p *Person) Validate() error {
+	if err := p.personCenturyError(); err != nil {
+		return err
+	}
+
 	if err := p.SetDate(); err != nil {
-		return false
+		return err
 	}
 
-	return p.Parsed.Valid()
+	return p.Parsed.Validate()
 }
 
-// String returns the string representation of a person.
-func (p *Person) String() string {
-	cd := 0
-	if p.ControlDigit != nil {
-		cd = *p.ControlDigit
+// personCenturyError returns a ValidationError if the century is the 16 prefix
+// used for organizations, which is never a birth century.
+func (p *Parsed) personCenturyError() error {
+	if p.Century != 1600 {
+		return nil
 	}
 
-	return fmt.Sprintf(
-		"%02d%02d%02d%s%03d%d",
-		p.Year, p.Month, p.GetDay(),
-		p.Divider, p.Serial, cd,
-	)
+	return p.validationError(ErrInvalidCentury, PositionCentury, "not 16", "16")
+}
+
+// String returns the string representation of a person, the same as
+// Format(FormatShort).
+func (p *Person) String() string {
+	return p.Format(FormatShort)
 }
 
 // SetCentury will update the century for the person based on the input data if
@@ -149,39 +222,71 @@ func (p *Person) String() string {
 //  * If the year, month and date has NOT passed
 //    - If the divider is + -> use the century before the last
 //    - If the divider is - -> Use the last century.
+//
+// The reference date is taken from the options the person was created with,
+// defaulting to the current time.
 func (p *Person) SetCentury() error {
+	return p.SetCenturyAt(p.options.now())
+}
+
+// SetCenturyAt works like SetCentury but resolves the century against the
+// passed reference time instead of the current time.
+func (p *Person) SetCenturyAt(t time.Time) error {
 	// Nothing to do if already set.
 	if p.Century != 0 {
 		return nil
 	}
 
-	personDateWithCurrentCentury, err := time.Parse(
-		"2006-01-02",
-		fmt.Sprintf(
-			"%02d%02d-%02d-%02d",
-			time.Now().Year()/100, p.Year, p.Month, p.GetDay(),
-		),
-	)
+	century, ok := resolveCentury(p.Year, p.Month, p.GetDay(), p.Divider, t)
+	if !ok {
+		return p.dateError()
+	}
 
-	if err != nil {
-		return errors.New("invalid format")
+	p.Century = century
+
+	return nil
+}
+
+// resolveCentury returns the century for a date without century at the
+// reference time t, see SetCentury. It returns false if the date isn't valid
+// in the century of t.
+func resolveCentury(year, month, day int, divider Divider, t time.Time) (int, bool) {
+	currentCentury := t.Year() / 100 * 100
+
+	if !validDate(currentCentury+year, month, day) {
+		return 0, false
 	}
 
+	personDateWithCurrentCentury := time.Date(
+		currentCentury+year, time.Month(month), day, 0, 0, 0, 0, time.UTC,
+	)
+
 	// If the date passed have not passed, assumed they meant last century.
 	// 830101-1110 -> 19140101-1110
 	// 140101-1110 -> 20140101-1110
-	if personDateWithCurrentCentury.After(time.Now()) {
+	if personDateWithCurrentCentury.After(t) {
 		personDateWithCurrentCentury = personDateWithCurrentCentury.AddDate(-100, 0, 0)
 	}
 
 	// If the divider '+' is used this means that the person is
-	if p.Divider == DividerPlus {
+	if divider == DividerPlus {
 		personDateWithCurrentCentury = personDateWithCurrentCentury.AddDate(-100, 0, 0)
 	}
 
-	p.Century = personDateWithCurrentCentury.Year() / 100 * 100
+	return personDateWithCurrentCentury.Year() / 100 * 100, true
+}
 
-	return nil
+// validDate returns if the year, month and day forms a valid date. Only four
+// digit years are valid.
+func validDate(year, month, day int) bool {
+	if year < 1000 || year > 9999 || month < 1 || month > 12 || day < 1 {
+		return false
+	}
+
+	// Day 0 of the next month is the last day of this month.
+	daysInMonth := time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	return day <= daysInMonth
 }
 
 // SetDate will set a time.Time type on the Person struct.
@@ -194,25 +299,30 @@ func (p *Person) SetDate() error {
 		return err
 	}
 
-	t, err := time.Parse(
-		"2006-01-02",
-		fmt.Sprintf(
-			"%d-%02d-%02d",
-			p.Century+p.Year,
-			p.Month,
-			p.GetDay(),
-		),
-	)
-
-	if err != nil {
-		return err
+	if !validDate(p.Century+p.Year, p.Month, p.GetDay()) {
+		return p.dateError()
 	}
 
-	p.Date = t
+	p.Date = time.Date(p.Century+p.Year, time.Month(p.Month), p.GetDay(), 0, 0, 0, 0, time.UTC)
 
 	return nil
 }
 
+// dateError returns a ValidationError for a person where the parsed parts
+// doesn't form a valid date.
+func (p *Parsed) dateError() error {
+	if p.Month < 1 || p.Month > 12 {
+		return p.validationError(ErrInvalidDate, PositionMonth, "", fmt.Sprintf("%02d", p.Month))
+	}
+
+	reason := ErrInvalidDate
+	if p.Day > minCoordinationNumber {
+		reason = ErrInvalidCoordinationDay
+	}
+
+	return p.validationError(reason, PositionDay, "", fmt.Sprintf("%02d", p.Day))
+}
+
 // SetZodiac will set the zodiac sign on the Person struct.
 func (p *Person) SetZodiac() error {
 	if err := p.SetDate(); err != nil {
@@ -243,15 +353,36 @@ func (p *Person) SetCounty() error {
 }
 
 // Age returns the age of a person with a given personal number based on today's
-// date (UTC+0).
+// date, or the reference time from the options the person was created with.
+// See AgeDetailAt for how the age is calculated.
 func (p *Person) Age() int {
+	return p.AgeAt(p.options.now())
+}
+
+// AgeAt returns the age of a person with a given personal number at the passed
+// time. See AgeDetailAt for how the age is calculated.
+func (p *Person) AgeAt(t time.Time) int {
+	return p.AgeDetailAt(t).Years
+}
+
+// AgeDetail returns the age of a person in years, months and days based on
+// today's date, or the reference time from the options the person was created
+// with.
+func (p *Person) AgeDetail() AgeDetail {
+	return p.AgeDetailAt(p.options.now())
+}
+
+// AgeDetailAt returns the age of a person in years, months and days at the
+// passed time. The age is calculated by calendar with the date of t in the
+// location from the options the person was created with, defaulting to
+// Europe/Stockholm. A person born on the 29th of February turns a year older
+// on the 1st of March in years that aren't leap years, see CalculateAge.
+func (p *Person) AgeDetailAt(t time.Time) AgeDetail {
 	if err := p.SetDate(); err != nil {
 		panic(err)
 	}
 
-	duration := time.Since(p.Date)
-
-	return int(math.Floor(duration.Hours() / 24 / 365))
+	return CalculateAge(p.Date, t, p.options.Location)
 }
 
 // IsOfAge checks if the age of a person with a given social security number has
@@ -260,6 +391,12 @@ func (p *Person) IsOfAge(age int) bool {
 	return p.Age() >= age
 }
 
+// IsOfAgeAt checks if the age of a person with a given social security number
+// has been reached at the passed time.
+func (p *Person) IsOfAgeAt(t time.Time, age int) bool {
+	return p.AgeAt(t) >= age
+}
+
 // Male returns true if the social security number serial number is uneven.
 func (p *Person) Male() bool {
 	return p.Gender == Male
@@ -284,12 +421,7 @@ func GenderFromSerial(serial int) Gender {
 // See dates https://en.wikipedia.org/wiki/Astrological_sign#Dates_table
 func ZodiacFromDate(d time.Time) Zodiac {
 	parse := func(ds string) time.Time {
-		t, err := time.Parse("2006-01-02", fmt.Sprintf("%d-%s", d.Year(), ds))
-		if err != nil {
-			panic(err)
-		}
-
-		return t
+		return time.Date(d.Year(), time.Month(atoi(ds[:2])), atoi(ds[3:]), 0, 0, 0, 0, time.UTC)
 	}
 
 	parseOffset := func(ds string, offset int) time.Time {
@@ -327,6 +459,17 @@ func ZodiacFromDate(d time.Time) Zodiac {
 	return Zodiac(-1)
 }
 
+func (g Gender) String() string {
+	switch g {
+	case Male:
+		return "Male"
+	case Female:
+		return "Female"
+	}
+
+	return "Unknown"
+}
+
 func (z Zodiac) String() string {
 	switch z {
 	case Aries:
@@ -357,62 +500,3 @@ func (z Zodiac) String() string {
 
 	return "Unknown"
 }
-
-// Generate will generate a valid Swedish social security number
-// based on passed year, month, day and sex.
-func Generate(date time.Time, sex Gender) (*Person, error) {
-	if sex != Male && sex != Female {
-		return nil, errors.New("invalid gender")
-	}
-
-	rand.Seed(time.Now().UnixNano())
-
-	sexIndications := map[Gender][]int{
-		Male:   {1, 3, 5, 7, 9},
-		Female: {2, 4, 6, 8, 0},
-	}
-
-	randStart := rand.Intn(99)
-	randSex := sexIndications[sex][rand.Intn(len(sexIndications[sex]))]
-	randSerial, _ := strconv.Atoi(fmt.Sprintf("%02d%d", randStart, randSex))
-
-	century := date.Year() / 100 * 100
-	parsed := &Parsed{
-		Century: century,
-		Year:    date.Year() % century,
-		Month:   int(date.Month()),
-		Day:     date.Day(),
-		Serial:  randSerial,
-	}
-
-	cs := parsed.LuhnChecksum()
-	cd := parsed.LuhnControlDigit(cs)
-
-	parsed.ControlDigit = &cd
-
-	person := &Person{
-		Parsed: parsed,
-		Gender: sex,
-	}
-
-	if err := person.SetDate(); err != nil {
-		return nil, err
-	}
-
-	return person, nil
-}
-
-// GenerateAny will generate a random valid Swedish social security number
-// with a random date and random sex.
-func GenerateAny() (*Person, error) {
-	var (
-		min   = time.Date(1974, 1, 0, 0, 0, 0, 0, time.UTC).Unix()
-		max   = time.Date(2014, 1, 0, 0, 0, 0, 0, time.UTC).Unix()
-		delta = max - min
-		sec   = rand.Int63n(delta) + min
-	)
-
-	sexes := []Gender{Male, Female}
-
-	return Generate(time.Unix(sec, 0), sexes[rand.Intn(len(sexes))])
-}
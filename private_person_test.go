@@ -44,6 +44,7 @@ func TestNewPerson(t *testing.T) {
 					Serial:       329,
 					ControlDigit: &four,
 					Divider:      DividerMinus,
+					Kind:         KindPerson,
 				},
 				Date:           d1,
 				IsCoordination: false,
@@ -64,6 +65,8 @@ func TestNewPerson(t *testing.T) {
 					Serial:       660,
 					ControlDigit: &three,
 					Divider:      DividerMinus,
+					Kind:         KindPerson,
+					hasDivider:   true,
 				},
 				IsCoordination: false,
 				County:         CountyUnknown,
@@ -83,6 +86,8 @@ func TestNewPerson(t *testing.T) {
 					Serial:       660,
 					ControlDigit: &three,
 					Divider:      DividerPlus,
+					Kind:         KindPerson,
+					hasDivider:   true,
 				},
 				IsCoordination: false,
 				County:         CountyT,
@@ -102,6 +107,8 @@ func TestNewPerson(t *testing.T) {
 					Serial:       329,
 					ControlDigit: &four,
 					Divider:      DividerMinus,
+					Kind:         KindPerson,
+					hasCentury:   true,
 				},
 				Date:           d1,
 				IsCoordination: false,
@@ -122,6 +129,8 @@ func TestNewPerson(t *testing.T) {
 					Serial:       329,
 					ControlDigit: &four,
 					Divider:      DividerPlus,
+					Kind:         KindPerson,
+					hasDivider:   true,
 				},
 				IsCoordination: false,
 				County:         CountyK,
@@ -141,6 +150,8 @@ func TestNewPerson(t *testing.T) {
 					Serial:       329,
 					ControlDigit: &four,
 					Divider:      DividerMinus,
+					Kind:         KindCoordination,
+					hasDivider:   true,
 				},
 				IsCoordination: true,
 				County:         CountyK,
@@ -160,6 +171,8 @@ func TestNewPerson(t *testing.T) {
 					Serial:       903,
 					ControlDigit: &three,
 					Divider:      DividerMinus,
+					Kind:         KindPerson,
+					hasDivider:   true,
 				},
 				County: CountyUnknown,
 				Gender: Male,
@@ -219,6 +232,7 @@ func TestIsValidPerson(t *testing.T) {
 		{pnr: "158001013294", valid: true},
 		{pnr: "21800101-3294", valid: true},
 		{pnr: "218001013294", valid: true},
+		{pnr: "16800101-3294", valid: false},
 		{pnr: "880435-3300", valid: false},
 		{pnr: "00000000-0001", valid: false},
 		// Coordination numbers
@@ -284,3 +298,60 @@ func TestZodiacFromDate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewPersonWithOptions(t *testing.T) {
+	cases := []struct {
+		description     string
+		input           string
+		now             time.Time
+		expectedCentury int
+		expectedAge     int
+	}{
+		{
+			description:     "before birthday",
+			input:           "140101-1110",
+			now:             time.Date(2013, 6, 1, 0, 0, 0, 0, time.UTC),
+			expectedCentury: 1900,
+			expectedAge:     99,
+		},
+		{
+			description:     "on birthday",
+			input:           "140101-1110",
+			now:             time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectedCentury: 2000,
+			expectedAge:     0,
+		},
+		{
+			description:     "plus divider",
+			input:           "140101+1110",
+			now:             time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectedCentury: 1900,
+			expectedAge:     100,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			now := tc.now
+			person, err := NewPersonWithOptions(tc.input, Options{
+				Now: func() time.Time { return now },
+			})
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedCentury, person.Century)
+			assert.Equal(t, tc.expectedAge, person.Age())
+			assert.Equal(t, tc.expectedAge, person.AgeAt(now))
+			assert.True(t, person.IsOfAgeAt(now, tc.expectedAge))
+			assert.False(t, person.IsOfAgeAt(now, tc.expectedAge+1))
+		})
+	}
+}
+
+func BenchmarkIsValidPerson(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = IsValidPerson("19800101-3294")
+	}
+}
@@ -0,0 +1,169 @@
+package personnummer
+
+import (
+	"database/sql/driver"
+	"strings"
+)
+
+// Scan implements sql.Scanner. Strings, bytes and integers are supported and
+// the value is validated. Scanning NULL returns ErrScanNull, use NullPerson for
+// nullable columns.
+func (p *Person) Scan(src interface{}) error {
+	if src == nil {
+		return ErrScanNull
+	}
+
+	return p.UnmarshalText([]byte(strings.TrimSpace(stringFromInterface(src))))
+}
+
+// Value implements driver.Valuer. The person is stored in the long twelve
+// digit form, YYYYMMDDNNNC.
+func (p Person) Value() (driver.Value, error) {
+	if p.Parsed == nil {
+		return nil, ErrInvalidFormat
+	}
+
+	return p.Format(FormatLong), nil
+}
+
+// Scan implements sql.Scanner. Strings, bytes and integers are supported and
+// the value is validated. Scanning NULL returns ErrScanNull, use
+// NullOrganization for nullable columns.
+func (o *Organization) Scan(src interface{}) error {
+	if src == nil {
+		return ErrScanNull
+	}
+
+	return o.UnmarshalText([]byte(strings.TrimSpace(stringFromInterface(src))))
+}
+
+// Value implements driver.Valuer. The organization is stored in the long
+// twelve digit form prefixed with 16, 16NNNNNNNNNN.
+func (o Organization) Value() (driver.Value, error) {
+	if o.Parsed == nil {
+		return nil, ErrInvalidFormat
+	}
+
+	return o.Format(FormatLong), nil
+}
+
+// Scan implements sql.Scanner. Strings, bytes and integers are supported and
+// the value is validated. Scanning NULL returns ErrScanNull, use
+// NullInterimNumber for nullable columns.
+func (i *InterimNumber) Scan(src interface{}) error {
+	if src == nil {
+		return ErrScanNull
+	}
+
+	return i.UnmarshalText([]byte(strings.TrimSpace(stringFromInterface(src))))
+}
+
+// Value implements driver.Valuer. The interim number is stored in the long
+// twelve character form with its letter or prefix, e.g. 19800101T124. Only
+// reserve numbers from Region Stockholm can be stored in an integer column.
+func (i InterimNumber) Value() (driver.Value, error) {
+	if i.Person == nil {
+		return nil, ErrInvalidFormat
+	}
+
+	return i.Format(FormatLong), nil
+}
+
+// NullPerson represents a Person that may be NULL. It implements sql.Scanner
+// and driver.Valuer the same way as sql.NullString.
+type NullPerson struct {
+	Person Person
+	Valid  bool // Valid is true if Person is not NULL
+}
+
+// Scan implements sql.Scanner.
+func (n *NullPerson) Scan(src interface{}) error {
+	if src == nil {
+		n.Person, n.Valid = Person{}, false
+
+		return nil
+	}
+
+	if err := n.Person.Scan(src); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (n NullPerson) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Person.Value()
+}
+
+// NullOrganization represents an Organization that may be NULL. It implements
+// sql.Scanner and driver.Valuer the same way as sql.NullString.
+type NullOrganization struct {
+	Organization Organization
+	Valid        bool // Valid is true if Organization is not NULL
+}
+
+// Scan implements sql.Scanner.
+func (n *NullOrganization) Scan(src interface{}) error {
+	if src == nil {
+		n.Organization, n.Valid = Organization{}, false
+
+		return nil
+	}
+
+	if err := n.Organization.Scan(src); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (n NullOrganization) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Organization.Value()
+}
+
+// NullInterimNumber represents an InterimNumber that may be NULL. It implements
+// sql.Scanner and driver.Valuer the same way as sql.NullString.
+type NullInterimNumber struct {
+	InterimNumber InterimNumber
+	Valid         bool // Valid is true if InterimNumber is not NULL
+}
+
+// Scan implements sql.Scanner.
+func (n *NullInterimNumber) Scan(src interface{}) error {
+	if src == nil {
+		n.InterimNumber, n.Valid = InterimNumber{}, false
+
+		return nil
+	}
+
+	if err := n.InterimNumber.Scan(src); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (n NullInterimNumber) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.InterimNumber.Value()
+}
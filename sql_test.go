@@ -0,0 +1,157 @@
+package personnummer
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Ensure the interfaces are implemented.
+var (
+	_ sql.Scanner   = &Person{}
+	_ driver.Valuer = Person{}
+	_ sql.Scanner   = &Organization{}
+	_ driver.Valuer = Organization{}
+	_ sql.Scanner   = &NullPerson{}
+	_ driver.Valuer = NullPerson{}
+	_ sql.Scanner   = &NullOrganization{}
+	_ driver.Valuer = NullOrganization{}
+	_ sql.Scanner   = &InterimNumber{}
+	_ driver.Valuer = InterimNumber{}
+	_ sql.Scanner   = &NullInterimNumber{}
+	_ driver.Valuer = NullInterimNumber{}
+)
+
+func TestPerson_Scan(t *testing.T) {
+	cases := []struct {
+		description string
+		src         interface{}
+		expected    driver.Value
+		wantErr     error
+	}{
+		{description: "char", src: "198001013294", expected: "198001013294"},
+		{description: "bytes", src: []byte("800101-3294"), expected: "198001013294"},
+		{description: "bigint", src: int64(198001013294), expected: "198001013294"},
+		{description: "padded char", src: "198001013294 ", expected: "198001013294"},
+		{description: "null", src: nil, wantErr: ErrScanNull},
+		{description: "invalid", src: "198001013295", wantErr: ErrInvalidChecksum},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			var p Person
+
+			err := p.Scan(tc.src)
+			if tc.wantErr != nil {
+				assert.True(t, errors.Is(err, tc.wantErr), "got %v", err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			v, err := p.Value()
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, v)
+		})
+	}
+}
+
+func TestOrganization_Scan(t *testing.T) {
+	var o Organization
+
+	require.NoError(t, o.Scan(int64(5567037485)))
+
+	v, err := o.Value()
+	require.NoError(t, err)
+
+	assert.Equal(t, "165567037485", v)
+	assert.Error(t, o.Scan("8001013294"))
+}
+
+func TestNullPerson_Scan(t *testing.T) {
+	var n NullPerson
+
+	require.NoError(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+
+	v, err := n.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	require.NoError(t, n.Scan("198001013294"))
+	assert.True(t, n.Valid)
+
+	v, err = n.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "198001013294", v)
+}
+
+func TestNullOrganization_Scan(t *testing.T) {
+	var n NullOrganization
+
+	require.NoError(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+
+	require.NoError(t, n.Scan("165567037485"))
+	assert.True(t, n.Valid)
+
+	v, err := n.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "165567037485", v)
+}
+
+func TestInterimNumber_Scan(t *testing.T) {
+	cases := []struct {
+		description string
+		src         interface{}
+		expected    driver.Value
+		wantErr     error
+	}{
+		{description: "char", src: "19800101T124", expected: "19800101T124"},
+		{description: "bytes", src: []byte("800101-T124"), expected: "19800101T124"},
+		{description: "stockholm bigint", src: int64(998001013294), expected: "998001013294"},
+		{description: "null", src: nil, wantErr: ErrScanNull},
+		{description: "personal identity number", src: "198001013294", wantErr: ErrInvalidFormat},
+		{description: "invalid", src: "19800101T125", wantErr: ErrInvalidChecksum},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			var i InterimNumber
+
+			err := i.Scan(tc.src)
+			if tc.wantErr != nil {
+				assert.True(t, errors.Is(err, tc.wantErr), "got %v", err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			v, err := i.Value()
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, v)
+		})
+	}
+}
+
+func TestNullInterimNumber_Scan(t *testing.T) {
+	var n NullInterimNumber
+
+	require.NoError(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+
+	require.NoError(t, n.Scan("19800101T124"))
+	assert.True(t, n.Valid)
+
+	v, err := n.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "19800101T124", v)
+}
@@ -0,0 +1,285 @@
+package personnummer
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// StreamValidator validates numbers read from an io.Reader, either one number
+// per line or one number per record in a given CSV column. The zero value
+// validates one personal identity number per line using one worker per CPU.
+type StreamValidator struct {
+	// CSV makes the input be read as CSV where the number is read from
+	// Column (zero based). A negative Column gives a single Result with
+	// ErrInvalidColumn and Row 0 without reading any input.
+	CSV    bool
+	Column int
+
+	// Comma is the CSV field delimiter. Defaults to ','.
+	Comma rune
+
+	// SkipHeader skips the first line or record.
+	SkipHeader bool
+
+	// Workers is the number of concurrent workers. Defaults to the number of
+	// CPUs.
+	Workers int
+
+	// ValidateFunc validates a single number. Defaults to validating it as a
+	// person using Options.
+	ValidateFunc func(input string) error
+
+	// Options are used by the default ValidateFunc.
+	Options Options
+}
+
+// Result is the result of validating one row.
+type Result struct {
+	// Row is the one based line or record number the input was read from.
+	Row   int
+	Input string
+
+	// Err is nil if the input is valid. If the input isn't valid it's the
+	// error from the ValidateFunc and if the row couldn't be read it's the
+	// read error.
+	Err error
+}
+
+// Valid returns true if the input is valid.
+func (r Result) Valid() bool {
+	return r.Err == nil
+}
+
+// Reason returns the reason the input is invalid. For a ValidationError this
+// is the Reason, for other errors the error itself and nil if valid.
+func (r Result) Reason() error {
+	var vErr *ValidationError
+	if errors.As(r.Err, &vErr) {
+		return vErr.Reason
+	}
+
+	return r.Err
+}
+
+// Summary holds the number of valid and invalid rows and the number of
+// invalid rows per reason.
+type Summary struct {
+	Valid   int
+	Invalid int
+	Reasons map[error]int
+}
+
+// Add adds the result to the summary.
+func (s *Summary) Add(r Result) {
+	if r.Valid() {
+		s.Valid++
+
+		return
+	}
+
+	if s.Reasons == nil {
+		s.Reasons = map[error]int{}
+	}
+
+	s.Invalid++
+	s.Reasons[r.Reason()]++
+}
+
+// String returns a human readable summary with the reasons sorted by name.
+func (s Summary) String() string {
+	var (
+		sb      strings.Builder
+		reasons = make([]error, 0, len(s.Reasons))
+	)
+
+	fmt.Fprintf(&sb, "valid: %d, invalid: %d", s.Valid, s.Invalid)
+
+	for reason := range s.Reasons {
+		reasons = append(reasons, reason)
+	}
+
+	sort.Slice(reasons, func(i, j int) bool {
+		return reasons[i].Error() < reasons[j].Error()
+	})
+
+	for _, reason := range reasons {
+		fmt.Fprintf(&sb, "\n  %s: %d", reason, s.Reasons[reason])
+	}
+
+	return sb.String()
+}
+
+// Summarize reads all results from the channel and returns a summary.
+func Summarize(results <-chan Result) Summary {
+	var s Summary
+
+	for r := range results {
+		s.Add(r)
+	}
+
+	return s
+}
+
+// Validate reads numbers from r and validates them concurrently. Results are
+// sent on the returned channel which is closed when all input is read and
+// validated or the context is cancelled. Results may be sent in another order
+// than the rows were read, use Row to tell them apart. The channel must be
+// read until closed or the context cancelled to not leak goroutines.
+func (v *StreamValidator) Validate(ctx context.Context, r io.Reader) <-chan Result {
+	var (
+		rows    = make(chan Result)
+		results = make(chan Result)
+		wg      sync.WaitGroup
+	)
+
+	validate := v.ValidateFunc
+	if validate == nil {
+		validate = func(input string) error {
+			person, err := NewPersonWithOptions(input, v.Options)
+			if err != nil {
+				return err
+			}
+
+			return person.Validate()
+		}
+	}
+
+	workers := v.Workers
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for row := range rows {
+				if row.Err == nil {
+					row.Err = validate(row.Input)
+				}
+
+				select {
+				case results <- row:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(rows)
+
+		if v.CSV {
+			v.readCSV(ctx, r, rows)
+		} else {
+			v.readLines(ctx, r, rows)
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+// readLines sends each non empty line from r as a row.
+func (v *StreamValidator) readLines(ctx context.Context, r io.Reader, rows chan<- Result) {
+	var (
+		scanner = bufio.NewScanner(r)
+		row     = 0
+	)
+
+	for scanner.Scan() {
+		row++
+
+		if row == 1 && v.SkipHeader {
+			continue
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		if !send(ctx, rows, Result{Row: row, Input: line}) {
+			return
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		send(ctx, rows, Result{Row: row + 1, Err: err})
+	}
+}
+
+// readCSV sends the configured column of each record from r as a row.
+func (v *StreamValidator) readCSV(ctx context.Context, r io.Reader, rows chan<- Result) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	if v.Comma != 0 {
+		reader.Comma = v.Comma
+	}
+
+	if v.Column < 0 {
+		send(ctx, rows, Result{Err: ErrInvalidColumn})
+
+		return
+	}
+
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+
+		if row == 1 && v.SkipHeader && err == nil {
+			continue
+		}
+
+		result := Result{Row: row, Err: err}
+
+		switch {
+		case err != nil:
+			// Malformed records are reported but we keep on reading.
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				send(ctx, rows, result)
+
+				return
+			}
+		case v.Column >= len(record):
+			result.Err = ErrInvalidFormat
+		default:
+			result.Input = strings.TrimSpace(record[v.Column])
+		}
+
+		if !send(ctx, rows, result) {
+			return
+		}
+	}
+}
+
+// send sends the result on the channel unless the context is cancelled. It
+// returns false if the context is cancelled.
+func send(ctx context.Context, ch chan<- Result, r Result) bool {
+	select {
+	case ch <- r:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
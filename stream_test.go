@@ -0,0 +1,138 @@
+package personnummer
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func collect(results <-chan Result) []Result {
+	var all []Result
+	for r := range results {
+		all = append(all, r)
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Row < all[j].Row
+	})
+
+	return all
+}
+
+func TestStreamValidator_Lines(t *testing.T) {
+	input := "800101-3294\n\n800101-3295\n801301-3294\n😸\n"
+
+	var v StreamValidator
+
+	results := collect(v.Validate(context.Background(), strings.NewReader(input)))
+	require.Len(t, results, 4)
+
+	assert.Equal(t, 1, results[0].Row)
+	assert.True(t, results[0].Valid())
+	assert.Nil(t, results[0].Reason())
+
+	assert.Equal(t, 3, results[1].Row)
+	assert.Equal(t, ErrInvalidChecksum, results[1].Reason())
+	assert.Equal(t, ErrInvalidDate, results[2].Reason())
+	assert.Equal(t, ErrInvalidFormat, results[3].Reason())
+}
+
+func TestStreamValidator_CSV(t *testing.T) {
+	input := strings.Join([]string{
+		"name;number",
+		"Alice;19800101-3294",
+		"Bob;556703-7485",
+		"Eve",
+	}, "\n")
+
+	v := StreamValidator{
+		CSV:        true,
+		Column:     1,
+		Comma:      ';',
+		SkipHeader: true,
+		Workers:    2,
+	}
+
+	results := collect(v.Validate(context.Background(), strings.NewReader(input)))
+	require.Len(t, results, 3)
+
+	assert.Equal(t, "19800101-3294", results[0].Input)
+	assert.True(t, results[0].Valid())
+	assert.Equal(t, ErrInvalidDate, results[1].Reason())
+	assert.Equal(t, ErrInvalidFormat, results[2].Reason())
+}
+
+func TestStreamValidator_NegativeColumn(t *testing.T) {
+	v := StreamValidator{CSV: true, Column: -1}
+
+	results := collect(v.Validate(context.Background(), strings.NewReader("19800101-3294\n")))
+	require.Len(t, results, 1)
+
+	assert.Equal(t, 0, results[0].Row)
+	assert.Equal(t, ErrInvalidColumn, results[0].Reason())
+}
+
+func TestStreamValidator_ValidateFunc(t *testing.T) {
+	v := StreamValidator{
+		ValidateFunc: func(input string) error {
+			org, err := NewOrganization(input)
+			if err != nil {
+				return err
+			}
+
+			return org.Validate()
+		},
+	}
+
+	summary := Summarize(v.Validate(context.Background(), strings.NewReader("556703-7485\n800101-3294\n")))
+
+	assert.Equal(t, 1, summary.Valid)
+	assert.Equal(t, 1, summary.Invalid)
+	assert.Equal(t, map[error]int{ErrInvalidThirdDigit: 1}, summary.Reasons)
+}
+
+func TestSummarize(t *testing.T) {
+	var (
+		sb        strings.Builder
+		generator = NewGeneratorWithSeed(1)
+	)
+
+	for i := 0; i < 1000; i++ {
+		person, err := generator.AnyPerson()
+		require.NoError(t, err)
+
+		sb.WriteString(person.Format(FormatLong) + "\n")
+	}
+
+	sb.WriteString("198001013295\n198001013296\n19801301-3294\n")
+
+	v := StreamValidator{Workers: 8}
+	summary := Summarize(v.Validate(context.Background(), strings.NewReader(sb.String())))
+
+	assert.Equal(t, 1000, summary.Valid)
+	assert.Equal(t, 3, summary.Invalid)
+	assert.Equal(t, map[error]int{ErrInvalidChecksum: 2, ErrInvalidDate: 1}, summary.Reasons)
+	assert.Equal(t, "valid: 1000, invalid: 3\n  invalid checksum: 2\n  invalid date: 1", summary.String())
+}
+
+func TestStreamValidator_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var v StreamValidator
+
+	results := v.Validate(ctx, strings.NewReader(strings.Repeat("800101-3294\n", 1000)))
+
+	<-results
+	cancel()
+
+	// The channel must be closed after cancel.
+	for range results {
+	}
+
+	assert.True(t, errors.Is(ctx.Err(), context.Canceled))
+}
@@ -0,0 +1,148 @@
+package personnummer
+
+//go:generate go run testnumbers_gen.go
+
+import (
+	"bufio"
+	_ "embed" // Used to embed the test numbers.
+	"io"
+	"strings"
+	"sync"
+)
+
+// nolint: gochecknoglobal
+var (
+	//go:embed testnumbers.txt
+	skatteverketTestNumbersData string
+
+	skatteverketTestNumbers     *TestNumbers
+	skatteverketTestNumbersOnce sync.Once
+)
+
+// TestNumbers is a set of personal identity numbers reserved for testing.
+type TestNumbers struct {
+	people []*Person
+	index  map[string]struct{}
+}
+
+// TestNumberFilter is used to select numbers from a TestNumbers set. The zero
+// value matches all numbers.
+type TestNumberFilter struct {
+	// MinBirthYear and MaxBirthYear limits the birth year, 0 means no limit.
+	MinBirthYear int
+	MaxBirthYear int
+
+	// Genders limits the genders, empty means any gender.
+	Genders []Gender
+}
+
+// SkatteverketTestNumbers returns the set of test personal identity numbers
+// published by Skatteverket that is embedded in the package. These numbers
+// will never be given to a real person.
+func SkatteverketTestNumbers() *TestNumbers {
+	skatteverketTestNumbersOnce.Do(func() {
+		tn, err := ParseTestNumbers(strings.NewReader(skatteverketTestNumbersData))
+		if err != nil {
+			panic(err)
+		}
+
+		skatteverketTestNumbers = tn
+	})
+
+	return skatteverketTestNumbers
+}
+
+// ParseTestNumbers reads a set of test numbers from r. Each line should hold
+// one valid personal identity number with century, empty lines and lines
+// starting with # are ignored.
+func ParseTestNumbers(r io.Reader) (*TestNumbers, error) {
+	tn := &TestNumbers{
+		index: map[string]struct{}{},
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		person, err := NewPerson(line)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := person.Validate(); err != nil {
+			return nil, err
+		}
+
+		tn.people = append(tn.people, person)
+		tn.index[person.Format(FormatLong)] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return tn, nil
+}
+
+// IsTestNumber returns if the person has one of the test numbers published by
+// Skatteverket.
+func IsTestNumber(p *Person) bool {
+	return SkatteverketTestNumbers().Contains(p)
+}
+
+// Len returns the number of test numbers in the set.
+func (t *TestNumbers) Len() int {
+	return len(t.people)
+}
+
+// Contains returns if the person has one of the numbers in the set.
+func (t *TestNumbers) Contains(p *Person) bool {
+	if p == nil || p.Parsed == nil {
+		return false
+	}
+
+	_, ok := t.index[p.Format(FormatLong)]
+
+	return ok
+}
+
+// Filter returns the numbers in the set matching the filter.
+func (t *TestNumbers) Filter(filter TestNumberFilter) []*Person {
+	var people []*Person
+
+	for _, p := range t.people {
+		if filter.matches(p) {
+			people = append(people, p)
+		}
+	}
+
+	return people
+}
+
+// matches returns if the person matches the filter.
+func (f TestNumberFilter) matches(p *Person) bool {
+	year := p.Date.Year()
+
+	if f.MinBirthYear != 0 && year < f.MinBirthYear {
+		return false
+	}
+
+	if f.MaxBirthYear != 0 && year > f.MaxBirthYear {
+		return false
+	}
+
+	if len(f.Genders) == 0 {
+		return true
+	}
+
+	for _, g := range f.Genders {
+		if p.Gender == g {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,118 @@
+//go:build ignore
+// +build ignore
+
+// This program downloads the test personal identity numbers published by
+// Skatteverket and writes them to testnumbers.txt. Run it with go generate.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	datasetURL = "https://skatteverket.entryscape.net/rowstore/dataset/b4de7df7-63c0-4e7e-bb59-1f156a591763"
+	pageSize   = 500
+	outputFile = "testnumbers.txt"
+)
+
+type page struct {
+	ResultCount int `json:"resultCount"`
+	Results     []struct {
+		Number string `json:"testpersonnummer"`
+	} `json:"results"`
+}
+
+func main() {
+	var numbers []string
+
+	for offset := 0; ; offset += pageSize {
+		p, err := fetch(offset)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for i, r := range p.Results {
+			nr := strings.TrimSpace(r.Number)
+
+			// A wrong field name gives empty numbers, fail instead of
+			// writing a list that doesn't hold any numbers.
+			if !isLongNumber(nr) {
+				log.Fatalf("result %d at offset %d: %q isn't a number in the format YYYYMMDDNNNC", i, offset, nr)
+			}
+
+			numbers = append(numbers, nr)
+		}
+
+		if len(p.Results) == 0 || offset+pageSize >= p.ResultCount {
+			break
+		}
+	}
+
+	if len(numbers) == 0 {
+		log.Fatalf("no numbers found at %s", datasetURL)
+	}
+
+	sort.Strings(numbers)
+
+	var sb strings.Builder
+
+	sb.WriteString(`# Test personal identity numbers (testpersonnummer) published by Skatteverket
+# as open data. These numbers are reserved for testing and will never be given
+# to a real person.
+#
+# Source: ` + datasetURL + `
+#
+# Generated by testnumbers_gen.go, run ` + "`go generate`" + ` to update. One number per
+# line in the long format, YYYYMMDDNNNC.
+`)
+
+	for _, nr := range numbers {
+		sb.WriteString(nr + "\n")
+	}
+
+	if err := os.WriteFile(outputFile, []byte(sb.String()), 0o644); err != nil { // nolint: gosec
+		log.Fatal(err)
+	}
+}
+
+func fetch(offset int) (*page, error) {
+	url := fmt.Sprintf("%s/json?_limit=%d&_offset=%d", datasetURL, pageSize, offset)
+
+	resp, err := http.Get(url) // nolint: gosec, noctx
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status from %s: %s", url, resp.Status)
+	}
+
+	var p page
+	if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// isLongNumber returns if the number is twelve digits.
+func isLongNumber(nr string) bool {
+	if len(nr) != 12 {
+		return false
+	}
+
+	for _, c := range nr {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,138 @@
+package personnummer
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNumbersData = `# Comment
+
+198001013294
+200903146603
+201803772381
+`
+
+func TestParseTestNumbers(t *testing.T) {
+	tn, err := ParseTestNumbers(strings.NewReader(testNumbersData))
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, tn.Len())
+
+	for _, input := range []string{"800101-3294", "19800101-3294", "090314-6603"} {
+		person, err := NewPerson(input)
+		require.NoError(t, err)
+
+		assert.True(t, tn.Contains(person), input)
+	}
+
+	person, err := NewPerson("800101+3294")
+	require.NoError(t, err)
+
+	assert.False(t, tn.Contains(person))
+	assert.False(t, tn.Contains(nil))
+
+	_, err = ParseTestNumbers(strings.NewReader("198001013295"))
+	assert.True(t, errors.Is(err, ErrInvalidChecksum))
+}
+
+func TestTestNumbers_Filter(t *testing.T) {
+	tn, err := ParseTestNumbers(strings.NewReader(testNumbersData))
+	require.NoError(t, err)
+
+	cases := []struct {
+		description string
+		filter      TestNumberFilter
+		expected    []string
+	}{
+		{
+			description: "no filter",
+			filter:      TestNumberFilter{},
+			expected:    []string{"198001013294", "200903146603", "201803772381"},
+		},
+		{
+			description: "min birth year",
+			filter:      TestNumberFilter{MinBirthYear: 2000},
+			expected:    []string{"200903146603", "201803772381"},
+		},
+		{
+			description: "max birth year and gender",
+			filter:      TestNumberFilter{MaxBirthYear: 2010, Genders: []Gender{Female}},
+			expected:    []string{"200903146603"},
+		},
+		{
+			description: "no match",
+			filter:      TestNumberFilter{MaxBirthYear: 1970},
+			expected:    nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			var numbers []string
+			for _, p := range tn.Filter(tc.filter) {
+				numbers = append(numbers, p.Format(FormatLong))
+			}
+
+			assert.Equal(t, tc.expected, numbers)
+		})
+	}
+}
+
+func TestGenerator_TestPerson(t *testing.T) {
+	tn, err := ParseTestNumbers(strings.NewReader(testNumbersData))
+	require.NoError(t, err)
+
+	g := NewGeneratorWithOptions(rand.NewSource(1), GeneratorOptions{
+		TestNumbers:     tn,
+		OnlyTestNumbers: true,
+	})
+
+	for i := 0; i < 20; i++ {
+		person, err := g.AnyPerson()
+		require.NoError(t, err)
+
+		assert.True(t, tn.Contains(person))
+	}
+
+	person, err := g.TestPerson(TestNumberFilter{Genders: []Gender{Male}})
+	require.NoError(t, err)
+
+	assert.Equal(t, "198001013294", person.Format(FormatLong))
+
+	_, err = g.TestPerson(TestNumberFilter{MaxBirthYear: 1970})
+	assert.True(t, errors.Is(err, ErrNoTestNumbers))
+}
+
+func TestGenerator_TestPersonEmpty(t *testing.T) {
+	tn, err := ParseTestNumbers(strings.NewReader("# No numbers\n"))
+	require.NoError(t, err)
+
+	g := NewGeneratorWithOptions(rand.NewSource(1), GeneratorOptions{TestNumbers: tn})
+
+	_, err = g.TestPerson(TestNumberFilter{})
+	assert.True(t, errors.Is(err, ErrEmptyTestNumbers))
+}
+
+func TestSkatteverketTestNumbers(t *testing.T) {
+	tn := SkatteverketTestNumbers()
+	require.NotNil(t, tn)
+
+	require.NotZero(t, tn.Len(), "testnumbers.txt holds no numbers, run go generate to download them")
+
+	people := tn.Filter(TestNumberFilter{})
+	require.Len(t, people, tn.Len())
+
+	for _, p := range people {
+		assert.True(t, IsTestNumber(p))
+	}
+
+	person, err := GenerateTestNumber(TestNumberFilter{})
+	require.NoError(t, err)
+
+	assert.True(t, IsTestNumber(person))
+}
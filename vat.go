@@ -0,0 +1,60 @@
+package personnummer
+
+import (
+	"strings"
+	"unicode"
+)
+
+// A Swedish VAT number (momsregistreringsnummer) is the country code SE, the
+// ten digit organization number without divider and 01.
+const (
+	vatPrefix = "SE"
+	vatSuffix = "01"
+)
+
+// VATNumber returns the VAT number for the organization, SEYYMMDDNNNC01.
+func (o *Organization) VATNumber() string {
+	return vatPrefix + o.Format(FormatShortNoDivider) + vatSuffix
+}
+
+// VATNumber returns the VAT number for a sole trader (enskild firma) owned by
+// the person, SEYYMMDDNNNC01. A sole trader uses the personal identity number
+// of the owner as organization number.
+func (p *Person) VATNumber() string {
+	return vatPrefix + p.Format(FormatShortNoDivider) + vatSuffix
+}
+
+// ParseVATNumber parses and validates a Swedish VAT number. The country code
+// may be in lower case and spaces and dashes are ignored, e.g.
+// "se 556703-7485 01".
+//
+// The returned Identity is of KindOrganization if the number is a valid
+// organization number and KindSoleTrader if it's a valid personal identity or
+// coordination number. ErrInvalidVATNumber is returned if the input isn't in
+// the VAT number format and a ValidationError if the number isn't valid.
+func ParseVATNumber(input string) (Identity, error) {
+	nr := strings.ToUpper(strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' {
+			return -1
+		}
+
+		return r
+	}, input))
+
+	if len(nr) != len(vatPrefix)+10+len(vatSuffix) ||
+		!strings.HasPrefix(nr, vatPrefix) ||
+		!strings.HasSuffix(nr, vatSuffix) {
+		return Identity{}, ErrInvalidVATNumber
+	}
+
+	digits := nr[len(vatPrefix) : len(nr)-len(vatSuffix)]
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return Identity{}, ErrInvalidVATNumber
+		}
+	}
+
+	// Identifying the number prefixed with 16 makes a valid personal identity
+	// number be identified as a sole trader.
+	return Identify("16" + digits)
+}
@@ -0,0 +1,74 @@
+package personnummer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganization_VATNumber(t *testing.T) {
+	for _, input := range []string{"556703-7485", "5567037485", "16556703-7485"} {
+		org, err := NewOrganization(input)
+		require.NoError(t, err)
+
+		assert.Equal(t, "SE556703748501", org.VATNumber())
+	}
+}
+
+func TestPerson_VATNumber(t *testing.T) {
+	for _, input := range []string{"800101-3294", "19800101-3294", "198001013294"} {
+		person, err := NewPerson(input)
+		require.NoError(t, err)
+
+		assert.Equal(t, "SE800101329401", person.VATNumber())
+	}
+}
+
+func TestParseVATNumber(t *testing.T) {
+	cases := []struct {
+		input  string
+		kind   Kind
+		number string
+		err    error
+	}{
+		{input: "SE556703748501", kind: KindOrganization, number: "556703-7485"},
+		{input: "se556703748501", kind: KindOrganization, number: "556703-7485"},
+		{input: " SE 5567037485 01 ", kind: KindOrganization, number: "556703-7485"},
+		{input: "SE 556703-7485 01", kind: KindOrganization, number: "556703-7485"},
+		{input: "SE800101329401", kind: KindSoleTrader, number: "800101-3294"},
+		{input: "SE180377238101", kind: KindSoleTrader, number: "180377-2381"},
+		{input: "SE556703748601", err: ErrInvalidChecksum},
+		{input: "SE800101329501", err: ErrInvalidChecksum},
+		{input: "SE556703748502", err: ErrInvalidVATNumber},
+		{input: "NO556703748501", err: ErrInvalidVATNumber},
+		{input: "SE55670374850", err: ErrInvalidVATNumber},
+		{input: "SE55670374X501", err: ErrInvalidVATNumber},
+		{input: "5567037485", err: ErrInvalidVATNumber},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			identity, err := ParseVATNumber(tc.input)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "expected %v, got %v", tc.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.kind, identity.Kind)
+
+			if tc.kind == KindOrganization {
+				assert.Equal(t, tc.number, identity.Organization.String())
+				assert.Equal(t, "SE"+identity.Organization.Format(FormatShortNoDivider)+"01", identity.Organization.VATNumber())
+
+				return
+			}
+
+			assert.Equal(t, tc.number, identity.Person.Format(FormatShort))
+			assert.Equal(t, "SE"+identity.Person.Format(FormatShortNoDivider)+"01", identity.Person.VATNumber())
+		})
+	}
+}